@@ -13,22 +13,54 @@ import (
 	firebase "firebase.google.com/go/v4"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/elastic/go-elasticsearch/v8"
 	"github.com/garlicgarrison/chessvars-backend/graph"
 	"github.com/garlicgarrison/chessvars-backend/graph/generated"
 	"github.com/garlicgarrison/chessvars-backend/graph/resolver"
 	"github.com/garlicgarrison/chessvars-backend/middleware"
+	"github.com/garlicgarrison/chessvars-backend/pkg/challenge"
+	"github.com/garlicgarrison/chessvars-backend/pkg/chat"
+	"github.com/garlicgarrison/chessvars-backend/pkg/elasticsearch/index"
 	"github.com/garlicgarrison/chessvars-backend/pkg/elo"
 	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
 	"github.com/garlicgarrison/chessvars-backend/pkg/game"
+	"github.com/garlicgarrison/chessvars-backend/pkg/matchmaking"
+	"github.com/garlicgarrison/chessvars-backend/pkg/presence"
+	"github.com/garlicgarrison/chessvars-backend/pkg/pubsub"
+	"github.com/garlicgarrison/chessvars-backend/pkg/redis"
+	"github.com/garlicgarrison/chessvars-backend/pkg/stats"
 	"github.com/garlicgarrison/chessvars-backend/pkg/users"
 	"github.com/gorilla/websocket"
 	"github.com/kelseyhightower/envconfig"
+	"go.uber.org/zap"
 )
 
 type Config struct {
 	Port    int    `envconfig:"PORT" default:"8080"`
 	Address string `envconfig:"ADDRESS" default:"http://localhost:8080"`
 
+	// SweepInterval is how often abandoned and timed out games are closed
+	SweepInterval time.Duration `envconfig:"SWEEP_INTERVAL" default:"30s"`
+	// MatchInterval is how often players waiting in matchmaking are paired
+	MatchInterval time.Duration `envconfig:"MATCH_INTERVAL" default:"2s"`
+
+	// RedisURL shares the matchmaking queue, subscriptions and presence
+	// between instances, otherwise they are kept in memory
+	RedisURL string `envconfig:"REDIS_URL"`
+
+	// ElasticsearchURL mirrors ratings for leaderboards,
+	// which are unavailable without it
+	ElasticsearchURL string `envconfig:"ELASTICSEARCH_URL"`
+
+	// SpectatorDelay holds back the moves spectators see of a game
+	SpectatorDelay time.Duration `envconfig:"SPECTATOR_DELAY" default:"0s"`
+
+	// ChatBlockedWords are masked in chat messages
+	ChatBlockedWords []string `envconfig:"CHAT_BLOCKED_WORDS"`
+
+	// RatingAlgorithm is either elo or glicko2
+	RatingAlgorithm string `envconfig:"RATING_ALGORITHM" default:"elo"`
+
 	Firestore firestore.Config
 }
 
@@ -41,7 +73,6 @@ func main() {
 		fmt.Printf("failed to process configs: %s\n", err)
 		os.Exit(1)
 	}
-	fmt.Printf("config: %v", cfg)
 
 	/* start section: third party */
 	app, err := firebase.NewApp(ctx, nil)
@@ -61,6 +92,29 @@ func main() {
 		log.Printf("error in intitializing firestore: %s \n", err)
 		os.Exit(1)
 	}
+
+	var ratings index.Index
+	if cfg.ElasticsearchURL != "" {
+		es, err := elasticsearch.NewClient(elasticsearch.Config{
+			Addresses: []string{cfg.ElasticsearchURL},
+		})
+		if err != nil {
+			log.Printf("error in initializing elasticsearch: %s\n", err)
+			os.Exit(1)
+		}
+
+		logger, err := zap.NewProduction()
+		if err != nil {
+			log.Printf("error in initializing logger: %s\n", err)
+			os.Exit(1)
+		}
+
+		ratings, err = index.NewIndex(ctx, es, elo.RATINGS_INDEX, elo.RATINGS_MAPPING, logger)
+		if err != nil {
+			log.Printf("error in initializing ratings index: %s\n", err)
+			os.Exit(1)
+		}
+	}
 	/* end section: third party */
 
 	/* start section: initialize server */
@@ -72,20 +126,106 @@ func main() {
 		os.Exit(1)
 	}
 
+	algorithm, err := elo.NewAlgorithm(cfg.RatingAlgorithm)
+	if err != nil {
+		fmt.Printf("failed to init rating algorithm: %s", err)
+		os.Exit(1)
+	}
+
 	elo, err := elo.NewService(elo.Config{
 		Firestore: fs,
+		Algorithm: algorithm,
+		Index:     ratings,
 	})
 	if err != nil {
-		fmt.Printf("failed to init users service: %s", err)
+		fmt.Printf("failed to init elo service: %s", err)
+		os.Exit(1)
+	}
+
+	stats, err := stats.NewService(stats.Config{
+		Firestore: fs,
+	})
+	if err != nil {
+		fmt.Printf("failed to init stats service: %s", err)
 		os.Exit(1)
 	}
 
 	game, err := game.NewService(game.Config{
-		Firestore:  fs,
-		EloService: elo,
+		Firestore:    fs,
+		EloService:   elo,
+		StatsService: stats,
 	})
 	if err != nil {
-		fmt.Printf("failed to init users service: %s", err)
+		fmt.Printf("failed to init game service: %s", err)
+		os.Exit(1)
+	}
+
+	queue := matchmaking.NewMemoryQueue()
+	broker := pubsub.NewMemoryBroker()
+	presenceStore := presence.NewMemoryStore()
+	if cfg.RedisURL != "" {
+		rc, err := redis.NewRedisClient(cfg.RedisURL)
+		if err != nil {
+			log.Printf("error in initializing redis: %s\n", err)
+			os.Exit(1)
+		}
+
+		queue, err = matchmaking.NewRedisQueue(rc)
+		if err != nil {
+			fmt.Printf("failed to init matchmaking queue: %s", err)
+			os.Exit(1)
+		}
+
+		broker, err = pubsub.NewRedisBroker(rc)
+		if err != nil {
+			fmt.Printf("failed to init pubsub broker: %s", err)
+			os.Exit(1)
+		}
+
+		presenceStore, err = presence.NewRedisStore(rc)
+		if err != nil {
+			fmt.Printf("failed to init presence store: %s", err)
+			os.Exit(1)
+		}
+	}
+
+	presences, err := presence.NewService(presence.Config{
+		Store: presenceStore,
+	})
+	if err != nil {
+		fmt.Printf("failed to init presence service: %s", err)
+		os.Exit(1)
+	}
+
+	matchmaker, err := matchmaking.NewService(matchmaking.Config{
+		Queue:       queue,
+		GameService: game,
+		EloService:  elo,
+	})
+	if err != nil {
+		fmt.Printf("failed to init matchmaking service: %s", err)
+		os.Exit(1)
+	}
+
+	challenges, err := challenge.NewService(challenge.Config{
+		Firestore:   fs,
+		GameService: game,
+	})
+	if err != nil {
+		fmt.Printf("failed to init challenge service: %s", err)
+		os.Exit(1)
+	}
+
+	chats, err := chat.NewService(chat.Config{
+		Firestore:   fs,
+		GameService: game,
+		Filter: chat.NewFilters(
+			chat.DefaultFilter(),
+			chat.NewProfanityFilter(cfg.ChatBlockedWords),
+		),
+	})
+	if err != nil {
+		fmt.Printf("failed to init chat service: %s", err)
 		os.Exit(1)
 	}
 
@@ -94,13 +234,26 @@ func main() {
 			Users: users,
 			Game:  game,
 			Elo:   elo,
+			Stats: stats,
+
+			Matchmaking: matchmaker,
+			Challenge:   challenges,
+			Chats:       chats,
+			Presence:    presences,
+
+			SpectatorDelay: cfg.SpectatorDelay,
 		},
+		Broker: broker,
 	})
 	if err != nil {
 		fmt.Printf("failed to init resolver: %s\n", err)
 		os.Exit(1)
 	}
 
+	workerCtx, stopWorkers := context.WithCancel(ctx)
+	go runSweeper(workerCtx, game, resolver, cfg.SweepInterval)
+	go runMatchmaker(workerCtx, matchmaker, cfg.MatchInterval)
+
 	graphql := handler.New(
 		generated.NewExecutableSchema(
 			generated.Config{
@@ -121,7 +274,7 @@ func main() {
 		},
 		InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, error) {
 			log.Printf("init payload %v", initPayload)
-			return initWebsocket(ctx, client, initPayload)
+			return initWebsocket(ctx, client, presences, initPayload)
 		},
 	})
 	/* end section: initialize server */
@@ -162,6 +315,7 @@ func main() {
 
 		<-signalChan
 		log.Printf("signaling server shutdown\n")
+		stopWorkers()
 		if err := server.Shutdown(ctx); err != nil {
 			log.Printf("error in signaling shutdown: %s\n", err)
 			return
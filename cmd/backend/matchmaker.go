@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/matchmaking"
+)
+
+// runMatchmaker pairs the players waiting in matchmaking every interval
+// until ctx is done
+func runMatchmaker(ctx context.Context, matchmaker matchmaking.Service, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			res, err := matchmaker.Match(ctx, matchmaking.MatchRequest{})
+			if err != nil {
+				log.Printf("[runMatchmaker] -- could not match players: %s\n", err)
+				continue
+			}
+
+			if len(res.Matches) > 0 {
+				log.Printf("[runMatchmaker] -- started %d games\n", len(res.Matches))
+			}
+		}
+	}
+}
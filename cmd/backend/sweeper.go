@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/graph"
+	"github.com/garlicgarrison/chessvars-backend/pkg/game"
+)
+
+// runSweeper closes abandoned and timed out games every interval
+// until ctx is done, telling the observers of each one about it
+func runSweeper(ctx context.Context, games game.Service, resolver *graph.Resolver, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			res, err := games.SweepGames(ctx, game.SweepGamesRequest{})
+			if err != nil {
+				log.Printf("[runSweeper] -- could not sweep games: %s\n", err)
+				continue
+			}
+
+			for _, swept := range res.Games {
+				resolver.NotifySwept(ctx, swept)
+			}
+
+			if len(res.Games) > 0 {
+				log.Printf("[runSweeper] -- swept %d games\n", len(res.Games))
+			}
+		}
+	}
+}
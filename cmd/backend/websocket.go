@@ -8,11 +8,19 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/garlicgarrison/chessvars-backend/middleware"
 	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/garlicgarrison/chessvars-backend/pkg/presence"
 )
 
-func initWebsocket(ctx context.Context, client *auth.Client, payload transport.InitPayload) (context.Context, error) {
+// initWebsocket signs in the connection, and keeps the user online for as
+// long as ctx, which lives until the connection is closed
+func initWebsocket(ctx context.Context, client *auth.Client, presences presence.Service, payload transport.InitPayload) (context.Context, error) {
 	id := payload.Authorization()
 
+	// spectators do not have to sign in
+	if id == "" {
+		return ctx, nil
+	}
+
 	token, err := client.VerifyIDToken(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("[initWebsocket] -- could not verify token")
@@ -21,5 +29,12 @@ func initWebsocket(ctx context.Context, client *auth.Client, payload transport.I
 	userID := format.NewUserIDFromIdentifer(token.UID)
 	ctxNew := context.WithValue(ctx, middleware.AUTH_USER_CONTEXT_KEY, userID)
 
+	err = presences.Connect(ctx, presence.ConnectRequest{
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[initWebsocket] -- could not connect user")
+	}
+
 	return ctxNew, nil
 }
@@ -0,0 +1,90 @@
+// Command backfill-elos carries the ratings and history kept before ratings
+// were split by category over to the legacy category of the game.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/garlicgarrison/chessvars-backend/pkg/elasticsearch/index"
+	"github.com/garlicgarrison/chessvars-backend/pkg/elo"
+	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
+	"github.com/kelseyhightower/envconfig"
+	"go.uber.org/zap"
+)
+
+type Config struct {
+	// ElasticsearchURL and RatingAlgorithm are those of the backend,
+	// so the ratings carried over are indexed and seeded the same way
+	ElasticsearchURL string `envconfig:"ELASTICSEARCH_URL"`
+	RatingAlgorithm  string `envconfig:"RATING_ALGORITHM" default:"elo"`
+
+	Firestore firestore.Config
+}
+
+func main() {
+	ctx := context.Background()
+
+	var cfg Config
+	err := envconfig.Process("", &cfg)
+	if err != nil {
+		fmt.Printf("failed to process configs: %s\n", err)
+		os.Exit(1)
+	}
+
+	fs, err := firestore.NewClient(ctx, &cfg.Firestore)
+	if err != nil {
+		log.Printf("error in intitializing firestore: %s \n", err)
+		os.Exit(1)
+	}
+
+	var ratings index.Index
+	if cfg.ElasticsearchURL != "" {
+		es, err := elasticsearch.NewClient(elasticsearch.Config{
+			Addresses: []string{cfg.ElasticsearchURL},
+		})
+		if err != nil {
+			log.Printf("error in initializing elasticsearch: %s\n", err)
+			os.Exit(1)
+		}
+
+		logger, err := zap.NewProduction()
+		if err != nil {
+			log.Printf("error in initializing logger: %s\n", err)
+			os.Exit(1)
+		}
+
+		ratings, err = index.NewIndex(ctx, es, elo.RATINGS_INDEX, elo.RATINGS_MAPPING, logger)
+		if err != nil {
+			log.Printf("error in initializing ratings index: %s\n", err)
+			os.Exit(1)
+		}
+	}
+
+	algorithm, err := elo.NewAlgorithm(cfg.RatingAlgorithm)
+	if err != nil {
+		fmt.Printf("failed to init rating algorithm: %s", err)
+		os.Exit(1)
+	}
+
+	eloService, err := elo.NewService(elo.Config{
+		Firestore: fs,
+		Algorithm: algorithm,
+		Index:     ratings,
+	})
+	if err != nil {
+		fmt.Printf("failed to init elo service: %s", err)
+		os.Exit(1)
+	}
+
+	reply, err := eloService.MigrateElos(ctx, elo.MigrateElosRequest{})
+	if err != nil {
+		log.Printf("failed to migrate elos: %s\n", err)
+		os.Exit(1)
+	}
+
+	log.Printf("migrated %d ratings and %d history entries of %d users\n", reply.Ratings, reply.Points, reply.Users)
+}
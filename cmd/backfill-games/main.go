@@ -0,0 +1,51 @@
+// Command backfill-games saves every game with its derived fields worked
+// out again, such as the players and the matchup its history is queried by.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
+	"github.com/garlicgarrison/chessvars-backend/pkg/game"
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Config struct {
+	Firestore firestore.Config
+}
+
+func main() {
+	ctx := context.Background()
+
+	var cfg Config
+	err := envconfig.Process("", &cfg)
+	if err != nil {
+		fmt.Printf("failed to process configs: %s\n", err)
+		os.Exit(1)
+	}
+
+	fs, err := firestore.NewClient(ctx, &cfg.Firestore)
+	if err != nil {
+		log.Printf("error in intitializing firestore: %s \n", err)
+		os.Exit(1)
+	}
+
+	gameService, err := game.NewService(game.Config{
+		Firestore: fs,
+	})
+	if err != nil {
+		fmt.Printf("failed to init game service: %s", err)
+		os.Exit(1)
+	}
+
+	reply, err := gameService.RefreshGames(ctx, game.RefreshGamesRequest{})
+	if err != nil {
+		log.Printf("failed to refresh games: %s\n", err)
+		os.Exit(1)
+	}
+
+	log.Printf("refreshed %d games\n", reply.Games)
+}
@@ -0,0 +1,61 @@
+// Command backfill-stats works the stats of every user out again from
+// the games collection, replacing the counters kept as games finish.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
+	"github.com/garlicgarrison/chessvars-backend/pkg/game"
+	"github.com/garlicgarrison/chessvars-backend/pkg/stats"
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Config struct {
+	Firestore firestore.Config
+}
+
+func main() {
+	ctx := context.Background()
+
+	var cfg Config
+	err := envconfig.Process("", &cfg)
+	if err != nil {
+		fmt.Printf("failed to process configs: %s\n", err)
+		os.Exit(1)
+	}
+
+	fs, err := firestore.NewClient(ctx, &cfg.Firestore)
+	if err != nil {
+		log.Printf("error in intitializing firestore: %s \n", err)
+		os.Exit(1)
+	}
+
+	statsService, err := stats.NewService(stats.Config{
+		Firestore: fs,
+	})
+	if err != nil {
+		fmt.Printf("failed to init stats service: %s", err)
+		os.Exit(1)
+	}
+
+	gameService, err := game.NewService(game.Config{
+		Firestore:    fs,
+		StatsService: statsService,
+	})
+	if err != nil {
+		fmt.Printf("failed to init game service: %s", err)
+		os.Exit(1)
+	}
+
+	reply, err := gameService.RebuildStats(ctx, game.RebuildStatsRequest{})
+	if err != nil {
+		log.Printf("failed to rebuild stats: %s\n", err)
+		os.Exit(1)
+	}
+
+	log.Printf("rebuilt stats of %d users from %d games\n", reply.Users, reply.Games)
+}
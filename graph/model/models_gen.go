@@ -10,6 +10,10 @@ import (
 	"github.com/garlicgarrison/chessvars-backend/graph/resolver"
 )
 
+type GameEvent interface {
+	IsGameEvent()
+}
+
 type MutationResponse interface {
 	IsMutationResponse()
 	GetCode() int
@@ -28,6 +32,99 @@ func (this BasicMutationResponse) GetCode() int       { return this.Code }
 func (this BasicMutationResponse) GetSuccess() bool   { return this.Success }
 func (this BasicMutationResponse) GetMessage() string { return this.Message }
 
+type ChallengeMutationResponse struct {
+	Code      int                 `json:"code"`
+	Success   bool                `json:"success"`
+	Message   string              `json:"message"`
+	Challenge *resolver.Challenge `json:"challenge"`
+}
+
+func (ChallengeMutationResponse) IsMutationResponse()     {}
+func (this ChallengeMutationResponse) GetCode() int       { return this.Code }
+func (this ChallengeMutationResponse) GetSuccess() bool   { return this.Success }
+func (this ChallengeMutationResponse) GetMessage() string { return this.Message }
+
+type ChatMessage struct {
+	ID        string         `json:"id"`
+	From      *resolver.User `json:"from"`
+	Channel   ChatChannel    `json:"channel"`
+	Text      string         `json:"text"`
+	Timestamp string         `json:"timestamp"`
+}
+
+type ChatMessages struct {
+	Messages []*ChatMessage `json:"messages"`
+	Next     *string        `json:"next"`
+}
+
+type ChatMutationResponse struct {
+	Code        int          `json:"code"`
+	Success     bool         `json:"success"`
+	Message     string       `json:"message"`
+	ChatMessage *ChatMessage `json:"chatMessage"`
+}
+
+func (ChatMutationResponse) IsMutationResponse()     {}
+func (this ChatMutationResponse) GetCode() int       { return this.Code }
+func (this ChatMutationResponse) GetSuccess() bool   { return this.Success }
+func (this ChatMutationResponse) GetMessage() string { return this.Message }
+
+type ClockUpdate struct {
+	Game             *resolver.Game `json:"game"`
+	PlayerOneClock   int            `json:"playerOneClock"`
+	PlayerTwoClock   int            `json:"playerTwoClock"`
+	PlayerOnePeriods int            `json:"playerOnePeriods"`
+	PlayerTwoPeriods int            `json:"playerTwoPeriods"`
+}
+
+func (ClockUpdate) IsGameEvent() {}
+
+type DrawDeclined struct {
+	Game *resolver.Game `json:"game"`
+	By   *resolver.User `json:"by"`
+}
+
+func (DrawDeclined) IsGameEvent() {}
+
+type DrawOffered struct {
+	Game *resolver.Game `json:"game"`
+	From *resolver.User `json:"from"`
+}
+
+func (DrawOffered) IsGameEvent() {}
+
+type EloHistory struct {
+	Points []*EloPoint `json:"points"`
+	Next   *string     `json:"next"`
+}
+
+type EloPoint struct {
+	Elo       *int           `json:"elo"`
+	Deviation *float64       `json:"deviation"`
+	Delta     *int           `json:"delta"`
+	Game      *resolver.Game `json:"game"`
+	Timestamp *string        `json:"timestamp"`
+}
+
+type GameEnded struct {
+	Game      *resolver.Game      `json:"game"`
+	Winner    *resolver.User      `json:"winner"`
+	Draw      bool                `json:"draw"`
+	Aborted   bool                `json:"aborted"`
+	EndReason *resolver.EndReason `json:"endReason"`
+}
+
+func (GameEnded) IsGameEvent() {}
+
+type GameFilter struct {
+	Type      *GameType           `json:"type"`
+	Result    *GameStatus         `json:"result"`
+	Opponent  *string             `json:"opponent"`
+	TimeLimit *resolver.TimeLimit `json:"timeLimit"`
+	From      *string             `json:"from"`
+	To        *string             `json:"to"`
+}
+
 type GameMutationResponse struct {
 	Code    int            `json:"code"`
 	Success bool           `json:"success"`
@@ -40,11 +137,88 @@ func (this GameMutationResponse) GetCode() int       { return this.Code }
 func (this GameMutationResponse) GetSuccess() bool   { return this.Success }
 func (this GameMutationResponse) GetMessage() string { return this.Message }
 
+type GameOffer struct {
+	Kind   GameOfferKind   `json:"kind"`
+	Status GameOfferStatus `json:"status"`
+	From   *resolver.User  `json:"from"`
+	Game   *resolver.Game  `json:"game"`
+}
+
+type Games struct {
+	Games []*resolver.Game `json:"games"`
+	Next  *string          `json:"next"`
+}
+
+type Leaderboard struct {
+	Entries []*LeaderboardEntry `json:"entries"`
+	Next    *string             `json:"next"`
+}
+
+type LeaderboardEntry struct {
+	Rank   int              `json:"rank"`
+	User   *resolver.User   `json:"user"`
+	Rating *resolver.Rating `json:"rating"`
+}
+
+type MovePlayed struct {
+	Game   *resolver.Game `json:"game"`
+	Player *resolver.User `json:"player"`
+	Move   *resolver.Move `json:"move"`
+	Index  int            `json:"index"`
+}
+
+func (MovePlayed) IsGameEvent() {}
+
 type Pagination struct {
 	Cursor *string `json:"cursor"`
 	Limit  *int    `json:"limit"`
 }
 
+type PlayerJoined struct {
+	Game   *resolver.Game `json:"game"`
+	Player *resolver.User `json:"player"`
+}
+
+func (PlayerJoined) IsGameEvent() {}
+
+type PlayerLeft struct {
+	Game        *resolver.Game `json:"game"`
+	Player      *resolver.User `json:"player"`
+	ClaimableAt string         `json:"claimableAt"`
+}
+
+func (PlayerLeft) IsGameEvent() {}
+
+type PlayerReturned struct {
+	Game   *resolver.Game `json:"game"`
+	Player *resolver.User `json:"player"`
+}
+
+func (PlayerReturned) IsGameEvent() {}
+
+type TakebackAnswered struct {
+	Game     *resolver.Game `json:"game"`
+	By       *resolver.User `json:"by"`
+	Accepted bool           `json:"accepted"`
+}
+
+func (TakebackAnswered) IsGameEvent() {}
+
+type TakebackRequested struct {
+	Game *resolver.Game `json:"game"`
+	From *resolver.User `json:"from"`
+}
+
+func (TakebackRequested) IsGameEvent() {}
+
+type TimeControlInput struct {
+	Base         int  `json:"base"`
+	Increment    *int `json:"increment"`
+	Delay        *int `json:"delay"`
+	Periods      *int `json:"periods"`
+	PeriodLength *int `json:"periodLength"`
+}
+
 type UserEditInput struct {
 	Username *string `json:"username"`
 	Bio      *string `json:"bio"`
@@ -67,6 +241,138 @@ type Users struct {
 	Next  *string          `json:"next"`
 }
 
+type ViewersChanged struct {
+	Game        *resolver.Game `json:"game"`
+	ViewerCount int            `json:"viewerCount"`
+}
+
+func (ViewersChanged) IsGameEvent() {}
+
+type ChatChannel string
+
+const (
+	ChatChannelPlayers    ChatChannel = "PLAYERS"
+	ChatChannelSpectators ChatChannel = "SPECTATORS"
+)
+
+var AllChatChannel = []ChatChannel{
+	ChatChannelPlayers,
+	ChatChannelSpectators,
+}
+
+func (e ChatChannel) IsValid() bool {
+	switch e {
+	case ChatChannelPlayers, ChatChannelSpectators:
+		return true
+	}
+	return false
+}
+
+func (e ChatChannel) String() string {
+	return string(e)
+}
+
+func (e *ChatChannel) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ChatChannel(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ChatChannel", str)
+	}
+	return nil
+}
+
+func (e ChatChannel) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type GameOfferKind string
+
+const (
+	GameOfferKindDraw     GameOfferKind = "DRAW"
+	GameOfferKindTakeback GameOfferKind = "TAKEBACK"
+)
+
+var AllGameOfferKind = []GameOfferKind{
+	GameOfferKindDraw,
+	GameOfferKindTakeback,
+}
+
+func (e GameOfferKind) IsValid() bool {
+	switch e {
+	case GameOfferKindDraw, GameOfferKindTakeback:
+		return true
+	}
+	return false
+}
+
+func (e GameOfferKind) String() string {
+	return string(e)
+}
+
+func (e *GameOfferKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GameOfferKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GameOfferKind", str)
+	}
+	return nil
+}
+
+func (e GameOfferKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type GameOfferStatus string
+
+const (
+	GameOfferStatusOffered  GameOfferStatus = "OFFERED"
+	GameOfferStatusAccepted GameOfferStatus = "ACCEPTED"
+	GameOfferStatusDeclined GameOfferStatus = "DECLINED"
+)
+
+var AllGameOfferStatus = []GameOfferStatus{
+	GameOfferStatusOffered,
+	GameOfferStatusAccepted,
+	GameOfferStatusDeclined,
+}
+
+func (e GameOfferStatus) IsValid() bool {
+	switch e {
+	case GameOfferStatusOffered, GameOfferStatusAccepted, GameOfferStatusDeclined:
+		return true
+	}
+	return false
+}
+
+func (e GameOfferStatus) String() string {
+	return string(e)
+}
+
+func (e *GameOfferStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GameOfferStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GameOfferStatus", str)
+	}
+	return nil
+}
+
+func (e GameOfferStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type GameStatus string
 
 const (
@@ -152,3 +458,48 @@ func (e *GameType) UnmarshalGQL(v interface{}) error {
 func (e GameType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type RatingCategory string
+
+const (
+	RatingCategoryBullet    RatingCategory = "BULLET"
+	RatingCategoryBlitz     RatingCategory = "BLITZ"
+	RatingCategoryRapid     RatingCategory = "RAPID"
+	RatingCategoryClassical RatingCategory = "CLASSICAL"
+)
+
+var AllRatingCategory = []RatingCategory{
+	RatingCategoryBullet,
+	RatingCategoryBlitz,
+	RatingCategoryRapid,
+	RatingCategoryClassical,
+}
+
+func (e RatingCategory) IsValid() bool {
+	switch e {
+	case RatingCategoryBullet, RatingCategoryBlitz, RatingCategoryRapid, RatingCategoryClassical:
+		return true
+	}
+	return false
+}
+
+func (e RatingCategory) String() string {
+	return string(e)
+}
+
+func (e *RatingCategory) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RatingCategory(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RatingCategory", str)
+	}
+	return nil
+}
+
+func (e RatingCategory) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
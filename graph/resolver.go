@@ -1,10 +1,24 @@
 package graph
 
 import (
-	"sync"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
 
+	"github.com/garlicgarrison/chessvars-backend/graph/model"
 	"github.com/garlicgarrison/chessvars-backend/graph/resolver"
+	"github.com/garlicgarrison/chessvars-backend/pkg/challenge"
+	"github.com/garlicgarrison/chessvars-backend/pkg/chat"
 	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/garlicgarrison/chessvars-backend/pkg/game"
+	"github.com/garlicgarrison/chessvars-backend/pkg/matchmaking"
+	"github.com/garlicgarrison/chessvars-backend/pkg/presence"
+	"github.com/garlicgarrison/chessvars-backend/pkg/pubsub"
+	"google.golang.org/grpc/codes"
 )
 
 // This file will not be regenerated automatically.
@@ -12,6 +26,10 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 type Config struct {
 	*resolver.Services
+
+	// Broker fans moves and events out to subscribers on every instance,
+	// which stays within this one when it is nil
+	Broker pubsub.Broker
 }
 
 type Resolver struct {
@@ -19,36 +37,992 @@ type Resolver struct {
 	*resolver.Services
 
 	/*
-		Key: gameID
-		Value: []chan *resolver.Move
-		NOTE: whenever a game changes moves,
-		everyone that subscribes should get a push
+		NOTE: whenever a game changes moves, it is published on
+		the moves topic of the game, see moveTopic. Every other change
+		to a game is published on its events topic, see gameTopic, which
+		reaches all of its observers including the player that made it.
+		Every game that is created, joined or aborted is published on
+		the lobby of its type, see lobbyTopic. Challenges are published
+		to the user that has to answer them and answers to the user that
+		sent them, see challengeTopic, and draw offers and takeback
+		requests to the opponent of the player that made them, as are
+		answers, see offerTopic.
 	*/
-	// moveChannels map[format.GameID](map[format.UserID]chan *resolver.Move)
+	Broker pubsub.Broker
+}
+
+// DELAY_BUFFER is how many events can wait out the spectator delay
+// before the subscription is closed like one that fell behind
+const DELAY_BUFFER = 256
+
+// SUBSCRIPTION_BUFFER is how far a subscriber can fall behind on the live
+// events of a game. Past that its subscription is closed rather than dropping
+// anything, and it picks up again by resubscribing with sinceMoveIndex, whose
+// replay waits for the subscriber however long the game is.
+const SUBSCRIPTION_BUFFER = 16
+
+// MAX_USERS_ONLINE is how many users usersOnline looks up at once
+const MAX_USERS_ONLINE = 100
+
+// MoveMessage is what is published on the moves topic of a game,
+// where a nil Move means the moves were taken back to Index
+type MoveMessage struct {
+	UserID format.UserID      `json:"user_id"`
+	Index  int                `json:"index"`
+	Move   *game.MoveResponse `json:"move"`
+}
+
+type GameEventKind string
+
+const (
+	PLAYER_JOINED      GameEventKind = "player_joined"
+	MOVE_PLAYED        GameEventKind = "move_played"
+	CLOCK_UPDATE       GameEventKind = "clock_update"
+	DRAW_OFFERED       GameEventKind = "draw_offered"
+	DRAW_DECLINED      GameEventKind = "draw_declined"
+	TAKEBACK_REQUESTED GameEventKind = "takeback_requested"
+	TAKEBACK_ANSWERED  GameEventKind = "takeback_answered"
+	GAME_ENDED         GameEventKind = "game_ended"
+	PLAYER_LEFT        GameEventKind = "player_left"
+	PLAYER_RETURNED    GameEventKind = "player_returned"
+	VIEWERS_CHANGED    GameEventKind = "viewers_changed"
+)
+
+// GameUpdate is what is published on the events topic of a game,
+// the events of one change along with the game as it was after it
+type GameUpdate struct {
+	Game   *game.Game    `json:"game"`
+	Events []GameMessage `json:"events"`
+}
 
-	GamesMovesMap sync.Map
+// GameMessage is an event of a GameUpdate, with what it takes
+// besides the game to make the GameEvent, see gameEvent
+type GameMessage struct {
+	Kind GameEventKind `json:"kind"`
+	// UserID is the player the event is about
+	UserID      format.UserID `json:"user_id,omitempty"`
+	Index       int           `json:"index,omitempty"`
+	Accepted    bool          `json:"accepted,omitempty"`
+	ViewerCount int           `json:"viewer_count,omitempty"`
 }
 
-type Observers struct {
-	MoveObservers sync.Map
+// OfferMessage is what is published on the offers topic of a player
+type OfferMessage struct {
+	Kind   model.GameOfferKind   `json:"kind"`
+	Status model.GameOfferStatus `json:"status"`
+	UserID format.UserID         `json:"user_id"`
+	Game   *game.Game            `json:"game"`
 }
 
-type MoveObserver struct {
+// GameObserver is a subscription to the events of a game,
+// which only the goroutine streaming them sends to and closes
+type GameObserver struct {
 	UserID format.UserID
-	Move   chan *resolver.Move
+	GameID format.GameID
+	Event  chan model.GameEvent
+
+	closed bool
+}
+
+// replay pushes the event once the observer takes it,
+// and returns false if ctx is done first
+func (o *GameObserver) replay(ctx context.Context, event model.GameEvent) bool {
+	if o.closed {
+		return false
+	}
+
+	select {
+	case o.Event <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// send pushes the event without waiting, closing the subscription
+// instead if the observer is too far behind, and returns false
+// once the subscription is closed
+func (o *GameObserver) send(event model.GameEvent) bool {
+	if o.closed {
+		return false
+	}
+
+	select {
+	case o.Event <- event:
+		return true
+	default:
+		o.close()
+		return false
+	}
+}
+
+func (o *GameObserver) close() {
+	if !o.closed {
+		o.closed = true
+		close(o.Event)
+	}
 }
 
 func NewResolver(cfg Config) (*Resolver, error) {
+	broker := cfg.Broker
+	if broker == nil {
+		broker = pubsub.NewMemoryBroker()
+	}
+
 	return &Resolver{
-		Services:      cfg.Services,
-		GamesMovesMap: sync.Map{},
+		Services: cfg.Services,
+		Broker:   broker,
 	}, nil
 }
 
-func (r *Resolver) getObserverMap(gameID format.GameID) *Observers {
-	game, _ := r.GamesMovesMap.LoadOrStore(gameID, &Observers{
-		MoveObservers: sync.Map{},
+func moveTopic(gameID format.GameID) string {
+	return "game:" + gameID.String() + ":moves"
+}
+
+func gameTopic(gameID format.GameID) string {
+	return "game:" + gameID.String() + ":events"
+}
+
+// lobbyTopic is where the games of the type are published,
+// and the games of every type when it is empty
+func lobbyTopic(gameType game.GameType) string {
+	if gameType == "" {
+		return "lobby"
+	}
+	return "lobby:" + gameType.String()
+}
+
+func challengeTopic(userID format.UserID) string {
+	return "user:" + userID.String() + ":challenges"
+}
+
+// offerTopic is where the offers made to the player in the game are published
+func offerTopic(gameID format.GameID, userID format.UserID) string {
+	return "game:" + gameID.String() + ":offers:" + userID.String()
+}
+
+// mover is the player that made the move at index
+func mover(data *game.Game, index int) format.UserID {
+	if index%2 == 1 {
+		return data.PlayerTwo
+	}
+	return data.PlayerOne
+}
+
+// played returns the index of the move the user just played, which is
+// followed by the forced reply of its opponent when it had no choice
+func played(userID format.UserID, data *game.Game) int {
+	index := len(data.Moves) - 1
+	if index > 0 && mover(data, index) != userID {
+		index--
+	}
+	return index
+}
+
+// publishMoves publishes the moves of the game from index on
+func (r *Resolver) publishMoves(ctx context.Context, data *game.Game, index int) error {
+	for i := index; i >= 0 && i < len(data.Moves); i++ {
+		err := r.publish(ctx, data.ID, MoveMessage{
+			UserID: mover(data, i),
+			Index:  i,
+			Move:   &data.Moves[i],
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// publishTakeback publishes that the moves of the game were taken back
+func (r *Resolver) publishTakeback(ctx context.Context, data *game.Game) error {
+	return r.publish(ctx, data.ID, MoveMessage{
+		Index: len(data.Moves),
+	})
+}
+
+func (r *Resolver) publish(ctx context.Context, gameID format.GameID, message MoveMessage) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	return r.Broker.Publish(ctx, moveTopic(gameID), data)
+}
+
+// spectator returns true if the user is not playing the game,
+// which is anyone that is not signed in
+func spectator(data *game.Game, userID format.UserID) bool {
+	return userID == "" || userID != data.PlayerOne && userID != data.PlayerTwo
+}
+
+// delayed passes on what comes in on c, each once delay has passed
+// since at returns, or since it came in when at is nil
+func delayed[T any](ctx context.Context, c <-chan T, delay time.Duration, at func(T) time.Time) <-chan T {
+	type item struct {
+		value T
+		due   time.Time
+	}
+
+	out := make(chan T, SUBSCRIPTION_BUFFER)
+	go func() {
+		defer close(out)
+
+		/*
+			Whatever comes in is due no earlier than what came before it,
+			so the timer only has to be set for the head of the queue
+			once the one before it has gone out.
+		*/
+		timer := time.NewTimer(time.Hour)
+		timer.Stop()
+		defer timer.Stop()
+		armed := false
+
+		queue := make([]item, 0)
+		for {
+			var due <-chan time.Time
+			if len(queue) > 0 {
+				if !armed {
+					timer.Reset(time.Until(queue[0].due))
+					armed = true
+				}
+				due = timer.C
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case value, ok := <-c:
+				if !ok || len(queue) >= DELAY_BUFFER {
+					return
+				}
+
+				start := time.Now()
+				if at != nil {
+					start = at(value)
+				}
+				queue = append(queue, item{value: value, due: start.Add(delay)})
+			case <-due:
+				armed = false
+				select {
+				case out <- queue[0].value:
+				case <-ctx.Done():
+					return
+				}
+				queue = queue[1:]
+			}
+		}
+	}()
+
+	return out
+}
+
+// streamMoves forwards the moves of the game from the broker to the
+// channel until ctx is done, skipping the ones of the user. Starting
+// from next, or from the first published move when it is negative,
+// anything the broker did not deliver is read from the game instead.
+// Only live moves end the stream once mc is full, as a replay waits.
+func (r *Resolver) streamMoves(ctx context.Context, gameID format.GameID, userID format.UserID, next int, messages <-chan []byte, mc chan<- *game.MoveResponse) {
+	// replay forwards the moves from next up to before to from the game,
+	// returning false if ctx is done first, which ends the stream
+	replay := func(before int) bool {
+		if next >= before {
+			return true
+		}
+
+		data, err := r.Services.Game.GetGame(ctx, game.GetGameRequest{
+			GameID: gameID,
+		})
+		if err != nil {
+			log.Printf("could not replay moves: %s", err)
+			return false
+		}
+
+		for ; next < before && next < len(data.Moves); next++ {
+			if mover(data, next) == userID {
+				continue
+			}
+
+			select {
+			case mc <- &data.Moves[next]:
+			case <-ctx.Done():
+				return false
+			}
+		}
+		return true
+	}
+
+	if next >= 0 && !replay(math.MaxInt) {
+		return
+	}
+
+	for data := range messages {
+		var message MoveMessage
+		if json.Unmarshal(data, &message) != nil {
+			continue
+		}
+
+		if message.Move == nil {
+			if next > message.Index {
+				next = message.Index
+			}
+			continue
+		}
+
+		if next < 0 {
+			next = message.Index
+		}
+		// a move that was replayed already
+		if message.Index < next {
+			continue
+		}
+		if !replay(message.Index) {
+			return
+		}
+		next = message.Index + 1
+
+		if message.UserID == userID {
+			continue
+		}
+
+		select {
+		case mc <- message.Move:
+		default:
+			return
+		}
+	}
+}
+
+func chatTopic(gameID format.GameID, channel chat.Channel) string {
+	return "game:" + gameID.String() + ":chat:" + string(channel)
+}
+
+// mutesTopic is where changes to who the user muted are published,
+// so that its chat subscriptions on every instance pick them up
+func mutesTopic(userID format.UserID) string {
+	return "user:" + userID.String() + ":mutes"
+}
+
+// publishChat publishes the message in the chat of its channel
+func (r *Resolver) publishChat(ctx context.Context, message *chat.Message) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	return r.Broker.Publish(ctx, chatTopic(message.GameID, message.Channel), data)
+}
+
+func newChatMessage(services *resolver.Services, message *chat.Message) *model.ChatMessage {
+	return &model.ChatMessage{
+		ID:        message.ID,
+		From:      resolver.NewUser(services, message.UserID),
+		Channel:   model.ChatChannel(strings.ToUpper(string(message.Channel))),
+		Text:      message.Text,
+		Timestamp: message.Timestamp.Format(time.RFC3339),
+	}
+}
+
+// streamChat forwards the messages of the chat to the channel until ctx
+// is done, leaving out the ones of users muted by the user, and closes
+// it once the subscriber falls behind like streamMoves
+func (r *Resolver) streamChat(ctx context.Context, userID format.UserID, messages, mutes <-chan []byte, cc chan<- *model.ChatMessage) {
+	muted := make(map[format.UserID]bool)
+	load := func() {
+		if userID == "" {
+			return
+		}
+
+		users, err := r.Services.Chats.GetMuted(ctx, chat.GetMutedRequest{
+			UserID: userID,
+		})
+		if err != nil {
+			log.Printf("could not get muted users: %s", err)
+			return
+		}
+
+		muted = make(map[format.UserID]bool)
+		for _, user := range users {
+			muted[user] = true
+		}
+	}
+	load()
+
+	for {
+		select {
+		case _, ok := <-mutes:
+			if !ok {
+				return
+			}
+			load()
+		case data, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			var message chat.Message
+			if json.Unmarshal(data, &message) != nil || muted[message.UserID] {
+				continue
+			}
+
+			select {
+			case cc <- newChatMessage(r.Services, &message):
+			default:
+				return
+			}
+		}
+	}
+}
+
+// notify publishes the message on the topic, where a failure
+// only costs the push since the change is saved either way
+func (r *Resolver) notify(ctx context.Context, topic string, message interface{}) {
+	data, err := json.Marshal(message)
+	if err != nil {
+		log.Printf("could not encode %s: %s", topic, err)
+		return
+	}
+
+	err = r.Broker.Publish(ctx, topic, data)
+	if err != nil {
+		log.Printf("could not publish %s: %s", topic, err)
+	}
+}
+
+// notifyLobby pushes the game to the lobby observers of its type
+func (r *Resolver) notifyLobby(ctx context.Context, data *game.Game) {
+	r.notify(ctx, lobbyTopic(data.Type), data)
+	r.notify(ctx, lobbyTopic(""), data)
+}
+
+// notifyChallenge pushes the challenge to the observers of the user
+func (r *Resolver) notifyChallenge(ctx context.Context, userID format.UserID, data *challenge.Challenge) {
+	r.notify(ctx, challengeTopic(userID), data)
+}
+
+// notifyOffer pushes what the user did with an offer to its opponent
+func (r *Resolver) notifyOffer(ctx context.Context, userID format.UserID, data *game.Game, kind model.GameOfferKind, status model.GameOfferStatus) {
+	opponent := data.PlayerOne
+	if opponent == userID {
+		opponent = data.PlayerTwo
+	}
+
+	r.notify(ctx, offerTopic(data.ID, opponent), OfferMessage{
+		Kind:   kind,
+		Status: status,
+		UserID: userID,
+		Game:   data,
+	})
+}
+
+// notifyGame pushes the events of a change to the game to its observers,
+// skipping the ones that are nil
+func (r *Resolver) notifyGame(ctx context.Context, data *game.Game, events ...*GameMessage) {
+	update := GameUpdate{
+		Game:   data,
+		Events: make([]GameMessage, 0, len(events)),
+	}
+	for _, event := range events {
+		if event != nil {
+			update.Events = append(update.Events, *event)
+		}
+	}
+	if len(update.Events) == 0 {
+		return
+	}
+
+	r.notify(ctx, gameTopic(data.ID), update)
+}
+
+// NotifySwept pushes a game the sweeper closed to its observers,
+// and to the lobby when nobody had joined it, like the mutations
+// that end a game do
+func (r *Resolver) NotifySwept(ctx context.Context, data *game.Game) {
+	if data.Aborted {
+		r.notifyLobby(ctx, data)
+	}
+	r.notifyGame(ctx, data, clockUpdate(data), gameEnded(data))
+}
+
+// streamLobby forwards the games published on a lobby to the channel.
+// The lobby is busy, so a slow observer misses a change instead of
+// holding up everyone else.
+func (r *Resolver) streamLobby(games <-chan []byte, gc chan<- *resolver.Game) {
+	for message := range games {
+		var data game.Game
+		if json.Unmarshal(message, &data) != nil {
+			continue
+		}
+
+		select {
+		case gc <- resolver.NewGameWithData(r.Services, &data):
+		default:
+		}
+	}
+}
+
+// streamChallenges forwards the challenges published to a user to the channel
+func (r *Resolver) streamChallenges(challenges <-chan []byte, cc chan<- *resolver.Challenge) {
+	for message := range challenges {
+		var data challenge.Challenge
+		if json.Unmarshal(message, &data) != nil {
+			continue
+		}
+
+		select {
+		case cc <- resolver.NewChallengeWithData(r.Services, &data):
+		default:
+		}
+	}
+}
+
+// streamOffers forwards the offers published to a player to the channel
+func (r *Resolver) streamOffers(offers <-chan []byte, oc chan<- *model.GameOffer) {
+	for message := range offers {
+		var offer OfferMessage
+		if json.Unmarshal(message, &offer) != nil || offer.Game == nil {
+			continue
+		}
+
+		select {
+		case oc <- &model.GameOffer{
+			Kind:   offer.Kind,
+			Status: offer.Status,
+			From:   resolver.NewUser(r.Services, offer.UserID),
+			Game:   resolver.NewGameWithData(r.Services, offer.Game),
+		}:
+		default:
+		}
+	}
+}
+
+// streamGame forwards the events published on the game to the observer
+// until the subscription is closed, either because ctx is done or
+// because the observer fell behind
+func (r *Resolver) streamGame(updates <-chan []byte, observer *GameObserver) {
+	for data := range updates {
+		var update GameUpdate
+		if json.Unmarshal(data, &update) != nil || update.Game == nil {
+			continue
+		}
+
+		for _, event := range update.Events {
+			if !observer.send(r.gameEvent(update.Game, event)) {
+				return
+			}
+		}
+	}
+}
+
+// gameEvent makes the GameEvent of the message about the game
+func (r *Resolver) gameEvent(data *game.Game, message GameMessage) model.GameEvent {
+	g := resolver.NewGameWithData(r.Services, data)
+	user := resolver.NewUser(r.Services, message.UserID)
+
+	switch message.Kind {
+	case PLAYER_JOINED:
+		return &model.PlayerJoined{Game: g, Player: user}
+	case MOVE_PLAYED:
+		return &model.MovePlayed{
+			Game:   g,
+			Player: resolver.NewUser(r.Services, mover(data, message.Index)),
+			Move:   resolver.NewMove(r.Services, &data.Moves[message.Index]),
+			Index:  message.Index,
+		}
+	case CLOCK_UPDATE:
+		return &model.ClockUpdate{
+			Game:             g,
+			PlayerOneClock:   int(data.PlayerOneClock.Time.Milliseconds()),
+			PlayerTwoClock:   int(data.PlayerTwoClock.Time.Milliseconds()),
+			PlayerOnePeriods: data.PlayerOneClock.Periods,
+			PlayerTwoPeriods: data.PlayerTwoClock.Periods,
+		}
+	case DRAW_OFFERED:
+		return &model.DrawOffered{Game: g, From: user}
+	case DRAW_DECLINED:
+		return &model.DrawDeclined{Game: g, By: user}
+	case TAKEBACK_REQUESTED:
+		return &model.TakebackRequested{Game: g, From: user}
+	case TAKEBACK_ANSWERED:
+		return &model.TakebackAnswered{Game: g, By: user, Accepted: message.Accepted}
+	case GAME_ENDED:
+		event := &model.GameEnded{
+			Game:    g,
+			Draw:    data.Draw,
+			Aborted: data.Aborted,
+		}
+		if data.WinnerID != "" {
+			event.Winner = resolver.NewUser(r.Services, data.WinnerID)
+		}
+		if data.EndReason != "" {
+			reason := resolver.EndReason(strings.ToUpper(string(data.EndReason)))
+			event.EndReason = &reason
+		}
+		return event
+	case PLAYER_LEFT:
+		since := data.PlayerOneAway
+		if message.UserID == data.PlayerTwo {
+			since = data.PlayerTwoAway
+		}
+		return &model.PlayerLeft{
+			Game:        g,
+			Player:      user,
+			ClaimableAt: since.Add(game.CLAIM_VICTORY_GRACE).Format(time.RFC3339),
+		}
+	case PLAYER_RETURNED:
+		return &model.PlayerReturned{Game: g, Player: user}
+	case VIEWERS_CHANGED:
+		return &model.ViewersChanged{Game: g, ViewerCount: message.ViewerCount}
+	default:
+		return nil
+	}
+}
+
+// movePlayed is the events of the move the user just played along with
+// the forced reply to it, or nil if it was not made by the user, such as
+// when its flag fell first
+func movePlayed(userID format.UserID, data *game.Game) []*GameMessage {
+	index := played(userID, data)
+	if index < 0 || mover(data, index) != userID {
+		return nil
+	}
+
+	events := make([]*GameMessage, 0)
+	for i := index; i < len(data.Moves); i++ {
+		events = append(events, &GameMessage{Kind: MOVE_PLAYED, Index: i})
+	}
+	return events
+}
+
+// replayGame pushes the moves of the game from index on to the observer,
+// followed by where the clocks and result stand now, waiting for the
+// observer to take each of them. It returns false if ctx is done first.
+func (r *Resolver) replayGame(ctx context.Context, observer *GameObserver, data *game.Game, index int) bool {
+	events := make([]*GameMessage, 0)
+	for i := index; i < len(data.Moves); i++ {
+		events = append(events, &GameMessage{Kind: MOVE_PLAYED, Index: i})
+	}
+	events = append(events, clockUpdate(data), gameEnded(data))
+
+	for _, event := range events {
+		if event != nil && !observer.replay(ctx, r.gameEvent(data, *event)) {
+			return false
+		}
+	}
+	return true
+}
+
+// leaveMatchmaking takes the user out of the queue once it has no
+// subscription waiting for a match left on any instance
+func (r *Resolver) leaveMatchmaking(userID format.UserID) {
+	ctx := context.Background()
+
+	presences, err := r.Services.Presence.GetPresence(ctx, presence.GetPresenceRequest{
+		UserIDs: []format.UserID{userID},
+	})
+	if err != nil {
+		log.Printf("could not get presence: %s", err)
+		return
+	}
+	if presences[userID].Searching {
+		return
+	}
+
+	_, err = r.Services.Matchmaking.Leave(ctx, matchmaking.LeaveRequest{
+		UserID: userID,
+	})
+	if err != nil {
+		log.Printf("could not leave matchmaking: %s", err)
+	}
+}
+
+// watch counts the spectator in the game until ctx is done, pushing
+// the new count to the observers of the game as it comes and goes
+func (r *Resolver) watch(ctx context.Context, gameID format.GameID) {
+	if r.Services.Presence == nil {
+		return
+	}
+
+	watched, err := r.Services.Presence.Watch(ctx, presence.WatchRequest{
+		GameID: gameID,
+	})
+	if err != nil {
+		log.Printf("could not count viewer of game %s: %s", gameID, err)
+		return
+	}
+	r.viewersChanged(ctx, gameID)
+
+	go func() {
+		<-watched.Left
+		r.viewersChanged(context.Background(), gameID)
+	}()
+}
+
+// viewersChanged pushes the number of spectators to the observers of the game
+func (r *Resolver) viewersChanged(ctx context.Context, gameID format.GameID) {
+	reply, err := r.Services.Presence.GetViewers(ctx, presence.GetViewersRequest{
+		GameID: gameID,
 	})
+	if err != nil {
+		log.Printf("could not count viewers of game %s: %s", gameID, err)
+		return
+	}
+
+	data, err := r.Services.Game.GetGame(ctx, game.GetGameRequest{
+		GameID: gameID,
+	})
+	if err != nil {
+		log.Printf("could not get game %s: %s", gameID, err)
+		return
+	}
+
+	r.notifyGame(ctx, data, &GameMessage{
+		Kind:        VIEWERS_CHANGED,
+		ViewerCount: reply.Count,
+	})
+}
 
-	return game.(*Observers)
+// attend counts the player in the game until ctx is done. A player coming
+// back to a game in progress is no longer away, and one whose last
+// subscription to it closes is away until it comes back, which lets its
+// opponent claim victory once the grace period is over.
+func (r *Resolver) attend(ctx context.Context, data *game.Game, userID format.UserID) {
+	if spectator(data, userID) || r.Services.Presence == nil {
+		return
+	}
+
+	entered, err := r.Services.Presence.EnterGame(ctx, presence.EnterGameRequest{
+		UserID: userID,
+		GameID: data.ID,
+	})
+	if err != nil {
+		log.Printf("could not enter game %s: %s", data.ID, err)
+		return
+	}
+
+	away := data.PlayerOneAway
+	if userID == data.PlayerTwo {
+		away = data.PlayerTwoAway
+	}
+	if !away.IsZero() {
+		r.setAway(ctx, data.ID, userID, false, time.Time{})
+	}
+
+	go func() {
+		<-entered.Left
+
+		presences, err := r.Services.Presence.GetPresence(context.Background(), presence.GetPresenceRequest{
+			UserIDs: []format.UserID{userID},
+		})
+		if err != nil {
+			log.Printf("could not get presence: %s", err)
+			return
+		}
+
+		if !presences[userID].InGame(data.ID) {
+			r.setAway(context.Background(), data.ID, userID, true, time.Time{})
+		}
+	}()
+}
+
+// findAway records the opponent of the user as away from the game since
+// it was last in it, for when that was missed, such as when the instance
+// its subscriptions were on went down before they could tell
+func (r *Resolver) findAway(ctx context.Context, gameID format.GameID, userID format.UserID) {
+	if r.Services.Presence == nil {
+		return
+	}
+
+	data, err := r.Services.Game.GetGame(ctx, game.GetGameRequest{
+		GameID: gameID,
+	})
+	if err != nil || spectator(data, userID) {
+		return
+	}
+
+	opponent, away := data.PlayerTwo, data.PlayerTwoAway
+	if userID == data.PlayerTwo {
+		opponent, away = data.PlayerOne, data.PlayerOneAway
+	}
+	if opponent == "" || !away.IsZero() {
+		return
+	}
+
+	reply, err := r.Services.Presence.GetAway(ctx, presence.GetAwayRequest{
+		UserID: opponent,
+		GameID: gameID,
+	})
+	if err != nil {
+		log.Printf("could not get away of game %s: %s", gameID, err)
+		return
+	}
+
+	if !reply.Since.IsZero() {
+		r.setAway(ctx, gameID, opponent, true, reply.Since)
+	}
+}
+
+// setAway records the player going away from the game since when, or now
+// when it is zero, or coming back, and tells the game about it, which is
+// left alone once the game is over or when another subscription of the
+// player already did
+func (r *Resolver) setAway(ctx context.Context, gameID format.GameID, userID format.UserID, away bool, since time.Time) {
+	reply, err := r.Services.Game.SetAway(ctx, game.SetAwayRequest{
+		UserID: userID,
+		GameID: gameID,
+		Away:   away,
+		Since:  since,
+	})
+	if err != nil {
+		if !game.IsNotAllowedError(err) {
+			log.Printf("could not set player away from game %s: %s", gameID, err)
+		}
+		return
+	}
+
+	kind := PLAYER_RETURNED
+	if away {
+		kind = PLAYER_LEFT
+	}
+	r.notifyGame(ctx, reply, &GameMessage{
+		Kind:   kind,
+		UserID: userID,
+	})
+}
+
+// clockUpdate is the event of the clocks of the game as it was read,
+// or nil when the game is not played on a clock
+func clockUpdate(data *game.Game) *GameMessage {
+	if !data.Timed() {
+		return nil
+	}
+	return &GameMessage{Kind: CLOCK_UPDATE}
+}
+
+// gameEnded is the event of the game being over, or nil while it is not
+func gameEnded(data *game.Game) *GameMessage {
+	if !data.Aborted && !data.Draw && data.WinnerID == "" {
+		return nil
+	}
+	return &GameMessage{Kind: GAME_ENDED}
+}
+
+// offerStatus is the status of an offer that was answered
+func offerStatus(accept bool) model.GameOfferStatus {
+	if accept {
+		return model.GameOfferStatusAccepted
+	}
+	return model.GameOfferStatusDeclined
+}
+
+// parseTimeControlInput converts a TimeControlInput in seconds,
+// returning nil if there is none
+func parseTimeControlInput(input *model.TimeControlInput) *game.TimeControl {
+	if input == nil {
+		return nil
+	}
+
+	tc := &game.TimeControl{
+		Base: time.Duration(input.Base) * time.Second,
+	}
+	if input.Increment != nil {
+		tc.Increment = time.Duration(*input.Increment) * time.Second
+	}
+	if input.Delay != nil {
+		tc.Delay = time.Duration(*input.Delay) * time.Second
+	}
+	if input.Periods != nil {
+		tc.Periods = *input.Periods
+	}
+	if input.PeriodLength != nil {
+		tc.PeriodLength = time.Duration(*input.PeriodLength) * time.Second
+	}
+	return tc
+}
+
+// challengeMutationError turns the errors a user can cause into
+// an unsuccessful response, and any other error into a failure
+func challengeMutationError(err error, message string) (*model.ChallengeMutationResponse, error) {
+	if challenge.IsNotAllowedError(err) || game.IsNotAllowedError(err) {
+		return &model.ChallengeMutationResponse{
+			Code:    int(codes.InvalidArgument),
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &model.ChallengeMutationResponse{
+		Code:    int(codes.Internal),
+		Success: false,
+		Message: message,
+	}, nil
+}
+
+// editMutes mutes or unmutes the other user for the signed in user
+func (r *mutationResolver) editMutes(ctx context.Context, otherUserID string, mute bool) (*model.BasicMutationResponse, error) {
+	userID, ok := resolver.GetAuthUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not validate user")
+	}
+
+	parsedUserID, err := format.ParseUserID(otherUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	request := chat.MuteUserRequest{
+		UserID:      userID,
+		OtherUserID: parsedUserID,
+	}
+	message := "user muted"
+	if mute {
+		err = r.Services.Chats.MuteUser(ctx, request)
+	} else {
+		err = r.Services.Chats.UnmuteUser(ctx, request)
+		message = "user unmuted"
+	}
+	if err != nil {
+		if chat.IsNotAllowedError(err) {
+			return &model.BasicMutationResponse{
+				Code:    int(codes.InvalidArgument),
+				Success: false,
+				Message: err.Error(),
+			}, nil
+		}
+
+		return &model.BasicMutationResponse{
+			Code:    int(codes.Internal),
+			Success: false,
+			Message: "could not change muted users",
+		}, nil
+	}
+
+	err = r.Broker.Publish(ctx, mutesTopic(userID), []byte(parsedUserID))
+	if err != nil {
+		log.Printf("could not publish muted users: %s", err)
+	}
+
+	return &model.BasicMutationResponse{
+		Code:    int(codes.OK),
+		Success: true,
+		Message: message,
+	}, nil
+}
+
+// gameMutationError turns the errors a user can cause into
+// an unsuccessful response, and any other error into a failure
+func gameMutationError(err error, message string) (*model.GameMutationResponse, error) {
+	if game.IsNotAllowedError(err) {
+		return &model.GameMutationResponse{
+			Code:    int(codes.InvalidArgument),
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &model.GameMutationResponse{
+		Code:    int(codes.Internal),
+		Success: false,
+		Message: message,
+	}, nil
 }
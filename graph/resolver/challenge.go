@@ -0,0 +1,161 @@
+package resolver
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/challenge"
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+)
+
+type Challenge struct {
+	services    *Services
+	challengeID format.ChallengeID
+
+	getter[*challenge.Challenge, func(context.Context) (*challenge.Challenge, error)]
+}
+
+type ChallengeStatus string
+
+const (
+	PENDING   ChallengeStatus = "PENDING"
+	ACCEPTED  ChallengeStatus = "ACCEPTED"
+	DECLINED  ChallengeStatus = "DECLINED"
+	CANCELLED ChallengeStatus = "CANCELLED"
+	EXPIRED   ChallengeStatus = "EXPIRED"
+)
+
+func NewChallenge(services *Services, challengeID format.ChallengeID) *Challenge {
+	return &Challenge{
+		services:    services,
+		challengeID: challengeID,
+		getter: NewGetter(func(ctx context.Context) (*challenge.Challenge, error) {
+			return services.Challenge.GetChallenge(ctx, challenge.GetChallengeRequest{
+				ChallengeID: challengeID,
+			})
+		}),
+	}
+}
+
+func NewChallengeWithData(services *Services, data *challenge.Challenge) *Challenge {
+	return &Challenge{
+		services:    services,
+		challengeID: data.ID,
+		getter: NewGetter(func(ctx context.Context) (*challenge.Challenge, error) {
+			return data, nil
+		}),
+	}
+}
+
+func (c *Challenge) ID(ctx context.Context) (string, error) {
+	return c.challengeID.String(), nil
+}
+
+func (c *Challenge) Challenger(ctx context.Context) (*User, error) {
+	challenge, err := c.getter.Call(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewUser(c.services, challenge.ChallengerID), nil
+}
+
+func (c *Challenge) Challenged(ctx context.Context) (*User, error) {
+	challenge, err := c.getter.Call(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewUser(c.services, challenge.ChallengedID), nil
+}
+
+func (c *Challenge) Type(ctx context.Context) (string, error) {
+	challenge, err := c.getter.Call(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return challenge.Type.String(), nil
+}
+
+func (c *Challenge) TimeLimit(ctx context.Context) (*TimeLimit, error) {
+	challenge, err := c.getter.Call(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	timeLimit := FormatTimeLimit(challenge.TimeLimit)
+	if timeLimit == NONE {
+		return nil, nil
+	}
+
+	return &timeLimit, nil
+}
+
+func (c *Challenge) TimeControl(ctx context.Context) (*TimeControl, error) {
+	challenge, err := c.getter.Call(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if challenge.TimeControl != nil {
+		return NewTimeControl(*challenge.TimeControl), nil
+	}
+
+	return NewTimeControl(challenge.TimeLimit.TimeControl()), nil
+}
+
+func (c *Challenge) RematchOf(ctx context.Context) (*Game, error) {
+	challenge, err := c.getter.Call(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if challenge.RematchOf == "" {
+		return nil, nil
+	}
+
+	return NewGame(c.services, challenge.RematchOf), nil
+}
+
+func (c *Challenge) Status(ctx context.Context) (*ChallengeStatus, error) {
+	challenge, err := c.getter.Call(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := ChallengeStatus(strings.ToUpper(string(challenge.Status)))
+	return &status, nil
+}
+
+func (c *Challenge) Game(ctx context.Context) (*Game, error) {
+	challenge, err := c.getter.Call(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if challenge.GameID == "" {
+		return nil, nil
+	}
+
+	return NewGame(c.services, challenge.GameID), nil
+}
+
+func (c *Challenge) ExpiresAt(ctx context.Context) (string, error) {
+	challenge, err := c.getter.Call(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return challenge.ExpiresAt.Format(time.RFC3339), nil
+}
+
+func (c *Challenge) Timestamp(ctx context.Context) (string, error) {
+	challenge, err := c.getter.Call(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return challenge.Timestamp.Format(time.RFC3339), nil
+}
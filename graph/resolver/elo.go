@@ -34,10 +34,8 @@ func (e *Elo) Janggi(ctx context.Context) (int, error) {
 		return 1200, err
 	}
 
-	for _, el := range reply.Elos {
-		if el.Game == elo.JANGGI {
-			return el.Elo, nil
-		}
+	if rating := MostPlayed(reply, elo.JANGGI); rating != nil {
+		return rating.Elo, nil
 	}
 
 	return 1200, err
@@ -49,11 +47,83 @@ func (e *Elo) Shogi(ctx context.Context) (int, error) {
 		return 1200, err
 	}
 
-	for _, el := range reply.Elos {
-		if el.Game == elo.SHOGI {
-			return el.Elo, nil
-		}
+	if rating := MostPlayed(reply, elo.SHOGI); rating != nil {
+		return rating.Elo, nil
 	}
 
 	return 1200, err
 }
+
+// MostPlayed returns the elo of the category of the game with
+// the most rated games, which is nil if the game was never played
+func MostPlayed(elos *elo.Elos, game elo.GameType) *elo.Elo {
+	var toRet *elo.Elo
+	for _, el := range elos.Elos {
+		if el.Game == game && (toRet == nil || el.Games > toRet.Games) {
+			toRet = el
+		}
+	}
+
+	return toRet
+}
+
+func (e *Elo) rating(ctx context.Context, game elo.GameType) (*elo.Elo, error) {
+	reply, err := e.getter.Call(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return MostPlayed(reply, game), nil
+}
+
+func (e *Elo) Ratings(ctx context.Context) ([]*Rating, error) {
+	reply, err := e.getter.Call(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ratings := make([]*Rating, 0, len(reply.Elos))
+	for _, el := range reply.Elos {
+		ratings = append(ratings, NewRating(el))
+	}
+
+	return ratings, nil
+}
+
+func (e *Elo) JanggiDeviation(ctx context.Context) (float64, error) {
+	return e.deviation(ctx, elo.JANGGI)
+}
+
+func (e *Elo) ShogiDeviation(ctx context.Context) (float64, error) {
+	return e.deviation(ctx, elo.SHOGI)
+}
+
+func (e *Elo) JanggiProvisional(ctx context.Context) (bool, error) {
+	return e.provisional(ctx, elo.JANGGI)
+}
+
+func (e *Elo) ShogiProvisional(ctx context.Context) (bool, error) {
+	return e.provisional(ctx, elo.SHOGI)
+}
+
+func (e *Elo) deviation(ctx context.Context, game elo.GameType) (float64, error) {
+	rating, err := e.rating(ctx, game)
+	if err != nil || rating == nil {
+		return 0, err
+	}
+
+	return rating.Deviation, nil
+}
+
+// provisional is true for a game that was never played
+func (e *Elo) provisional(ctx context.Context, game elo.GameType) (bool, error) {
+	rating, err := e.rating(ctx, game)
+	if err != nil {
+		return false, err
+	}
+	if rating == nil {
+		return true, nil
+	}
+
+	return rating.Provisional, nil
+}
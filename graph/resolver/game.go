@@ -2,10 +2,14 @@ package resolver
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/garlicgarrison/chessvars-backend/pkg/format"
 	game_pb "github.com/garlicgarrison/chessvars-backend/pkg/game"
+	"github.com/garlicgarrison/chessvars-backend/pkg/presence"
 )
 
 type Game struct {
@@ -28,6 +32,83 @@ const (
 	NONE   TimeLimit = "NONE"
 )
 
+// ParseTimeLimit returns the game time limit of a TimeLimit
+func ParseTimeLimit(limit TimeLimit) (game_pb.TimeLimit, error) {
+	switch limit {
+	case BULLET:
+		return game_pb.BULLET, nil
+	case BLITZ:
+		return game_pb.BLITZ, nil
+	case BLITZ2:
+		return game_pb.BLITZ2, nil
+	case RAPID:
+		return game_pb.RAPID, nil
+	case RAPID2:
+		return game_pb.RAPID2, nil
+	case RAPID3:
+		return game_pb.RAPID3, nil
+	case RAPID4:
+		return game_pb.RAPID4, nil
+	default:
+		return 0, fmt.Errorf("time limit not valid")
+	}
+}
+
+// FormatTimeLimit returns the TimeLimit of a game time limit,
+// which is NONE for games without a preset
+func FormatTimeLimit(limit game_pb.TimeLimit) TimeLimit {
+	switch limit {
+	case game_pb.BULLET:
+		return BULLET
+	case game_pb.BLITZ:
+		return BLITZ
+	case game_pb.BLITZ2:
+		return BLITZ2
+	case game_pb.RAPID:
+		return RAPID
+	case game_pb.RAPID2:
+		return RAPID2
+	case game_pb.RAPID3:
+		return RAPID3
+	case game_pb.RAPID4:
+		return RAPID4
+	default:
+		return NONE
+	}
+}
+
+type EndReason string
+
+const (
+	CHECKMATE  EndReason = "CHECKMATE"
+	STALEMATE  EndReason = "STALEMATE"
+	BIKJANG    EndReason = "BIKJANG"
+	REPETITION EndReason = "REPETITION"
+	// PERPETUAL_CHECK is a shogi repetition lost by the checking side
+	PERPETUAL_CHECK EndReason = "PERPETUAL_CHECK"
+	RESIGNATION     EndReason = "RESIGNATION"
+	AGREEMENT       EndReason = "AGREEMENT"
+	TIMEOUT         EndReason = "TIMEOUT"
+	ABANDONMENT     EndReason = "ABANDONMENT"
+)
+
+// Spectated returns the game as the user of ctx sees it, which leaves out
+// the moves of a game in progress that spectators are still held back from
+// by the spectator delay, and is the game itself for its players
+func Spectated(ctx context.Context, services *Services, data *game_pb.Game) (*game_pb.Game, error) {
+	if services.SpectatorDelay <= 0 || data.WinnerID != "" || data.Draw || data.Aborted {
+		return data, nil
+	}
+
+	// anyone not signed in is a spectator
+	userID, _ := GetAuthUserID(ctx)
+	if userID != "" && (userID == data.PlayerOne || userID == data.PlayerTwo) {
+		return data, nil
+	}
+
+	return data.Before(time.Now().Add(-services.SpectatorDelay))
+}
+
 func NewGame(services *Services, gameID format.GameID) *Game {
 	return &Game{
 		services: services,
@@ -41,7 +122,7 @@ func NewGame(services *Services, gameID format.GameID) *Game {
 				return nil, err
 			}
 
-			return game, nil
+			return Spectated(ctx, services, game)
 		}),
 	}
 }
@@ -51,7 +132,7 @@ func NewGameWithData(services *Services, data *game_pb.Game) *Game {
 		services: services,
 		gameID:   data.ID,
 		getter: NewGetter(func(ctx context.Context) (*game_pb.Game, error) {
-			return data, nil
+			return Spectated(ctx, services, data)
 		}),
 	}
 }
@@ -76,6 +157,15 @@ func (g *Game) Moves(ctx context.Context) ([]*Move, error) {
 	return toRet, nil
 }
 
+func (g *Game) Position(ctx context.Context) (string, error) {
+	game, err := g.getter.Call(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return game.Position, nil
+}
+
 func (g *Game) PlayerOne(ctx context.Context) (*User, error) {
 	game, err := g.getter.Call(ctx)
 	if err != nil {
@@ -133,6 +223,66 @@ func (g *Game) Aborted(ctx context.Context) (bool, error) {
 	return game.Aborted, nil
 }
 
+func (g *Game) EndReason(ctx context.Context) (*EndReason, error) {
+	game, err := g.getter.Call(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if game.EndReason == "" {
+		return nil, nil
+	}
+
+	reason := EndReason(strings.ToUpper(string(game.EndReason)))
+	return &reason, nil
+}
+
+func (g *Game) DrawOffer(ctx context.Context) (*User, error) {
+	game, err := g.getter.Call(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if game.DrawOffer == "" {
+		return nil, nil
+	}
+
+	return NewUser(g.services, game.DrawOffer), nil
+}
+
+func (g *Game) TakebackOffer(ctx context.Context) (*User, error) {
+	game, err := g.getter.Call(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if game.TakebackOffer == "" {
+		return nil, nil
+	}
+
+	return NewUser(g.services, game.TakebackOffer), nil
+}
+
+func (g *Game) ViewerCount(ctx context.Context) (int, error) {
+	game, err := g.getter.Call(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if g.services.Presence == nil {
+		return 0, nil
+	}
+
+	reply, err := g.services.Presence.GetViewers(ctx, presence.GetViewersRequest{
+		GameID: game.ID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return reply.Count, nil
+}
+
 func (g *Game) Type(ctx context.Context) (string, error) {
 	game, err := g.getter.Call(ctx)
 	if err != nil {
@@ -148,27 +298,98 @@ func (g *Game) TimeLimit(ctx context.Context) (TimeLimit, error) {
 		return NONE, err
 	}
 
-	var timeLimit TimeLimit
-	switch game.TimeLimit {
-	case game_pb.BULLET:
-		timeLimit = BULLET
-	case game_pb.BLITZ:
-		timeLimit = BLITZ
-	case game_pb.BLITZ2:
-		timeLimit = BLITZ2
-	case game_pb.RAPID:
-		timeLimit = RAPID
-	case game_pb.RAPID2:
-		timeLimit = RAPID2
-	case game_pb.RAPID3:
-		timeLimit = RAPID3
-	case game_pb.RAPID4:
-		timeLimit = RAPID4
-	default:
-		timeLimit = NONE
+	return FormatTimeLimit(game.TimeLimit), nil
+}
+
+func (g *Game) TimeControl(ctx context.Context) (*TimeControl, error) {
+	game, err := g.getter.Call(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTimeControl(game.TimeControl), nil
+}
+
+func (g *Game) PlayerOneClock(ctx context.Context) (int, error) {
+	game, err := g.getter.Call(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(game.PlayerOneClock.Time.Milliseconds()), nil
+}
+
+func (g *Game) PlayerTwoClock(ctx context.Context) (int, error) {
+	game, err := g.getter.Call(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(game.PlayerTwoClock.Time.Milliseconds()), nil
+}
+
+func (g *Game) PlayerOnePeriods(ctx context.Context) (int, error) {
+	game, err := g.getter.Call(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return game.PlayerOneClock.Periods, nil
+}
+
+func (g *Game) PlayerTwoPeriods(ctx context.Context) (int, error) {
+	game, err := g.getter.Call(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return game.PlayerTwoClock.Periods, nil
+}
+
+func (g *Game) PlayerOneEloDelta(ctx context.Context) (int, error) {
+	game, err := g.getter.Call(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return game.PlayerOneEloDelta, nil
+}
+
+func (g *Game) PlayerTwoEloDelta(ctx context.Context) (int, error) {
+	game, err := g.getter.Call(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return game.PlayerTwoEloDelta, nil
+}
+
+// awaySince formats when a player went away, nil while it is there
+func awaySince(since time.Time) *string {
+	if since.IsZero() {
+		return nil
+	}
+
+	formatted := since.Format(time.RFC3339)
+	return &formatted
+}
+
+func (g *Game) PlayerOneAwaySince(ctx context.Context) (*string, error) {
+	game, err := g.getter.Call(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return awaySince(game.PlayerOneAway), nil
+}
+
+func (g *Game) PlayerTwoAwaySince(ctx context.Context) (*string, error) {
+	game, err := g.getter.Call(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return timeLimit, nil
+	return awaySince(game.PlayerTwoAway), nil
 }
 
 func (g *Game) Timestamp(ctx context.Context) (string, error) {
@@ -0,0 +1,42 @@
+package resolver
+
+import (
+	"context"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/elo"
+)
+
+// Rating is the elo of a user in one variant and category
+type Rating struct {
+	data *elo.Elo
+}
+
+func NewRating(data *elo.Elo) *Rating {
+	return &Rating{
+		data: data,
+	}
+}
+
+func (r *Rating) Type(ctx context.Context) (string, error) {
+	return r.data.Game.String(), nil
+}
+
+func (r *Rating) Category(ctx context.Context) (string, error) {
+	return r.data.Category.String(), nil
+}
+
+func (r *Rating) Elo(ctx context.Context) (int, error) {
+	return r.data.Elo, nil
+}
+
+func (r *Rating) Deviation(ctx context.Context) (float64, error) {
+	return r.data.Deviation, nil
+}
+
+func (r *Rating) Provisional(ctx context.Context) (bool, error) {
+	return r.data.Provisional, nil
+}
+
+func (r *Rating) Games(ctx context.Context) (int, error) {
+	return r.data.Games, nil
+}
@@ -1,8 +1,15 @@
 package resolver
 
 import (
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/challenge"
+	"github.com/garlicgarrison/chessvars-backend/pkg/chat"
 	"github.com/garlicgarrison/chessvars-backend/pkg/elo"
 	"github.com/garlicgarrison/chessvars-backend/pkg/game"
+	"github.com/garlicgarrison/chessvars-backend/pkg/matchmaking"
+	"github.com/garlicgarrison/chessvars-backend/pkg/presence"
+	"github.com/garlicgarrison/chessvars-backend/pkg/stats"
 	"github.com/garlicgarrison/chessvars-backend/pkg/users"
 )
 
@@ -10,4 +17,13 @@ type Services struct {
 	Users users.Service
 	Game  game.Service
 	Elo   elo.Service
+	Stats stats.Service
+
+	Matchmaking matchmaking.Service
+	Challenge   challenge.Service
+	Chats       chat.Service
+	Presence    presence.Service
+
+	// SpectatorDelay holds back what spectators see of a game, see Spectated
+	SpectatorDelay time.Duration
 }
@@ -0,0 +1,26 @@
+package resolver
+
+import (
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/game"
+)
+
+// TimeControl is a game.TimeControl with durations in seconds
+type TimeControl struct {
+	Base         int
+	Increment    int
+	Delay        int
+	Periods      int
+	PeriodLength int
+}
+
+func NewTimeControl(tc game.TimeControl) *TimeControl {
+	return &TimeControl{
+		Base:         int(tc.Base / time.Second),
+		Increment:    int(tc.Increment / time.Second),
+		Delay:        int(tc.Delay / time.Second),
+		Periods:      tc.Periods,
+		PeriodLength: int(tc.PeriodLength / time.Second),
+	}
+}
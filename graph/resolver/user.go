@@ -4,6 +4,9 @@ import (
 	"context"
 
 	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/garlicgarrison/chessvars-backend/pkg/game"
+	"github.com/garlicgarrison/chessvars-backend/pkg/presence"
+	"github.com/garlicgarrison/chessvars-backend/pkg/stats"
 	"github.com/garlicgarrison/chessvars-backend/pkg/users"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -99,6 +102,60 @@ func (u *User) Elo(ctx context.Context) (*Elo, error) {
 	return NewElo(u.services, u.userID), nil
 }
 
+func (u *User) Stats(ctx context.Context) ([]*stats.GameStats, error) {
+	reply, err := u.services.Stats.GetStats(ctx, stats.GetStatsRequest{
+		UserID: u.userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.Games, nil
+}
+
+func (u *User) presence(ctx context.Context) (*presence.Presence, error) {
+	presences, err := u.services.Presence.GetPresence(ctx, presence.GetPresenceRequest{
+		UserIDs: []format.UserID{u.userID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return presences[u.userID], nil
+}
+
+func (u *User) Online(ctx context.Context) (bool, error) {
+	reply, err := u.presence(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return reply.Online, nil
+}
+
+func (u *User) CurrentGame(ctx context.Context) (*Game, error) {
+	reply, err := u.presence(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// a finished game can still be watched, but is no longer current
+	for _, gameID := range reply.GameIDs {
+		data, err := u.services.Game.GetGame(ctx, game.GetGameRequest{
+			GameID: gameID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if data.WinnerID == "" && !data.Draw && !data.Aborted {
+			return NewGameWithData(u.services, data), nil
+		}
+	}
+
+	return nil, nil
+}
+
 func (u *User) CreatedAt(ctx context.Context) (string, error) {
 	reply, err := u.getter.Call(ctx)
 	if err != nil {
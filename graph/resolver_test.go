@@ -0,0 +1,210 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/graph/model"
+	"github.com/garlicgarrison/chessvars-backend/graph/resolver"
+	"github.com/garlicgarrison/chessvars-backend/middleware"
+	"github.com/garlicgarrison/chessvars-backend/pkg/game"
+	"github.com/garlicgarrison/chessvars-backend/pkg/presence"
+	"github.com/garlicgarrison/chessvars-backend/pkg/pubsub"
+	"github.com/stretchr/testify/assert"
+)
+
+// gameService serves a single game, which is all the subscriptions read
+type gameService struct {
+	game.Service
+
+	data *game.Game
+}
+
+func (s *gameService) GetGame(context.Context, game.GetGameRequest) (*game.Game, error) {
+	return s.data, nil
+}
+
+// newTestResolver returns a resolver for a game with moves moves
+func newTestResolver(moves int) *Resolver {
+	data := &game.Game{
+		ID:        "igame",
+		PlayerOne: "iplayerone",
+		PlayerTwo: "iplayertwo",
+		Type:      game.JANGGI,
+		TimeControl: game.TimeControl{
+			Base: 10 * time.Minute,
+		},
+	}
+	for i := 0; i < moves; i++ {
+		data.Moves = append(data.Moves, game.MoveResponse{
+			Move:      game.MoveNotation(fmt.Sprintf("move%d", i)),
+			Timestamp: time.Now(),
+		})
+	}
+
+	return &Resolver{
+		Services: &resolver.Services{
+			Game: &gameService{data: data},
+		},
+		Broker: pubsub.NewMemoryBroker(),
+	}
+}
+
+func TestReplayGame(t *testing.T) {
+	moves := 3 * SUBSCRIPTION_BUFFER
+	r := newTestResolver(moves)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	since := 0
+	events, err := (&subscriptionResolver{r}).OnGameUpdate(ctx, "igame", &since)
+	assert.NoError(t, err)
+
+	// a replay longer than the buffer waits for the subscriber
+	time.Sleep(50 * time.Millisecond)
+
+	for i := 0; i < moves; i++ {
+		event, ok := <-events
+		assert.True(t, ok)
+		assert.Equal(t, i, event.(*model.MovePlayed).Index)
+	}
+
+	event, ok := <-events
+	assert.True(t, ok)
+	assert.IsType(t, &model.ClockUpdate{}, event)
+
+	// live events follow the replay
+	data, err := r.Services.Game.GetGame(ctx, game.GetGameRequest{})
+	assert.NoError(t, err)
+	r.notifyGame(ctx, data, &GameMessage{Kind: VIEWERS_CHANGED, ViewerCount: 2})
+
+	select {
+	case event, ok := <-events:
+		assert.True(t, ok)
+		assert.Equal(t, 2, event.(*model.ViewersChanged).ViewerCount)
+	case <-time.After(time.Second):
+		t.Fatal("no live event received")
+	}
+}
+
+func TestReplayUntimed(t *testing.T) {
+	r := newTestResolver(1)
+	data, err := r.Services.Game.GetGame(context.Background(), game.GetGameRequest{})
+	assert.NoError(t, err)
+	data.TimeControl = game.TimeControl{}
+
+	observer := &GameObserver{Event: make(chan model.GameEvent, SUBSCRIPTION_BUFFER)}
+	assert.True(t, r.replayGame(context.Background(), observer, data, 0))
+	close(observer.Event)
+
+	// only the move is replayed, as there is no clock to update
+	events := make([]model.GameEvent, 0)
+	for event := range observer.Event {
+		events = append(events, event)
+	}
+	assert.Len(t, events, 1)
+	assert.IsType(t, &model.MovePlayed{}, events[0])
+}
+
+func TestReplayMoves(t *testing.T) {
+	moves := 3 * SUBSCRIPTION_BUFFER
+	r := newTestResolver(moves)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	since := 0
+	mc, err := (&subscriptionResolver{r}).OnMoveNew(ctx, "igame", &since)
+	assert.NoError(t, err)
+
+	time.Sleep(50 * time.Millisecond)
+
+	for i := 0; i < moves; i++ {
+		move, ok := <-mc
+		assert.True(t, ok)
+
+		notation, err := move.Move(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("move%d", i), notation)
+	}
+}
+
+func TestDelayed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := make(chan int)
+	out := delayed[int](ctx, c, 20*time.Millisecond, nil)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		c <- i
+	}
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, i, <-out)
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+}
+
+// spectatedMoves sets the moves of the test game to one played well before
+// the spectator delay and one played just now
+func spectatedMoves(t *testing.T, r *Resolver) *game.Game {
+	data, err := r.Services.Game.GetGame(context.Background(), game.GetGameRequest{})
+	assert.NoError(t, err)
+	data.Moves = []game.MoveResponse{
+		{Move: "a1a2", Timestamp: time.Now().Add(-time.Hour)},
+		{Move: "a10a9", Timestamp: time.Now()},
+	}
+
+	return data
+}
+
+func TestSpectated(t *testing.T) {
+	r := newTestResolver(0)
+	r.Services.SpectatorDelay = time.Minute
+	data := spectatedMoves(t, r)
+
+	// only the move played before the delay is shown to spectators,
+	// while players see every move
+	spectated, err := resolver.Spectated(context.Background(), r.Services, data)
+	assert.NoError(t, err)
+	assert.Len(t, spectated.Moves, 1)
+
+	ctx := context.WithValue(context.Background(), middleware.AUTH_USER_CONTEXT_KEY, data.PlayerOne)
+	played, err := resolver.Spectated(ctx, r.Services, data)
+	assert.NoError(t, err)
+	assert.Len(t, played.Moves, 2)
+}
+
+func TestSpectatedCurrentGame(t *testing.T) {
+	r := newTestResolver(0)
+	r.Services.SpectatorDelay = time.Minute
+	data := spectatedMoves(t, r)
+
+	presences, err := presence.NewService(presence.Config{})
+	assert.NoError(t, err)
+	r.Services.Presence = presences
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, err = presences.EnterGame(ctx, presence.EnterGameRequest{
+		UserID: data.PlayerOne,
+		GameID: data.ID,
+	})
+	assert.NoError(t, err)
+
+	// a spectator looking up the game through one of its players
+	// is held back all the same
+	current, err := resolver.NewUser(r.Services, data.PlayerOne).CurrentGame(ctx)
+	assert.NoError(t, err)
+	assert.NotNil(t, current)
+
+	moves, err := current.Moves(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, moves, 1)
+}
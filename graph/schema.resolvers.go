@@ -9,18 +9,105 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/garlicgarrison/chessvars-backend/graph/generated"
 	"github.com/garlicgarrison/chessvars-backend/graph/model"
 	"github.com/garlicgarrison/chessvars-backend/graph/resolver"
+	"github.com/garlicgarrison/chessvars-backend/pkg/challenge"
+	"github.com/garlicgarrison/chessvars-backend/pkg/chat"
+	"github.com/garlicgarrison/chessvars-backend/pkg/elo"
 	"github.com/garlicgarrison/chessvars-backend/pkg/format"
 	"github.com/garlicgarrison/chessvars-backend/pkg/game"
+	"github.com/garlicgarrison/chessvars-backend/pkg/matchmaking"
+	"github.com/garlicgarrison/chessvars-backend/pkg/presence"
+	"github.com/garlicgarrison/chessvars-backend/pkg/stats"
 	"google.golang.org/grpc/codes"
 )
 
+// Type is the resolver for the type field.
+func (r *challengeResolver) Type(ctx context.Context, obj *resolver.Challenge) (*model.GameType, error) {
+	gameType, err := obj.Type(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	typeArg := model.GameType(strings.ToUpper(gameType))
+	if !typeArg.IsValid() {
+		return nil, fmt.Errorf("unknown game type: %s", gameType)
+	}
+
+	return &typeArg, nil
+}
+
 // Type is the resolver for the type field.
 func (r *gameResolver) Type(ctx context.Context, obj *resolver.Game) (*model.GameType, error) {
-	panic(fmt.Errorf("not implemented"))
+	gameType, err := obj.Type(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	typeArg := model.GameType(strings.ToUpper(gameType))
+	if !typeArg.IsValid() {
+		return nil, fmt.Errorf("unknown game type: %s", gameType)
+	}
+
+	return &typeArg, nil
+}
+
+// Chat is the resolver for the chat field.
+func (r *gameResolver) Chat(ctx context.Context, obj *resolver.Game, pagination *model.Pagination) (*model.ChatMessages, error) {
+	// spectators do not have to sign in to read their chat
+	userID, _ := resolver.GetAuthUserID(ctx)
+
+	id, err := obj.ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	request := chat.GetMessagesRequest{
+		UserID: userID,
+		GameID: format.GameID(id),
+	}
+	if pagination != nil {
+		if pagination.Limit != nil {
+			request.Limit = *pagination.Limit
+		}
+		if pagination.Cursor != nil {
+			request.Cursor = *pagination.Cursor
+		}
+	}
+
+	reply, err := r.Services.Chats.GetMessages(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*model.ChatMessage, 0)
+	for _, message := range reply.Messages {
+		messages = append(messages, newChatMessage(r.Services, message))
+	}
+
+	var next *string
+	if reply.Next != "" {
+		next = &reply.Next
+	}
+
+	return &model.ChatMessages{
+		Messages: messages,
+		Next:     next,
+	}, nil
+}
+
+// Type is the resolver for the type field.
+func (r *gameStatsResolver) Type(ctx context.Context, obj *stats.GameStats) (*model.GameType, error) {
+	typeArg := model.GameType(strings.ToUpper(obj.Game.String()))
+	if !typeArg.IsValid() {
+		return nil, fmt.Errorf("unknown game type: %s", obj.Game)
+	}
+
+	return &typeArg, nil
 }
 
 // UserEdit is the resolver for the userEdit field.
@@ -34,54 +121,55 @@ func (r *mutationResolver) UserDelete(ctx context.Context) (*model.BasicMutation
 }
 
 // GameCreate is the resolver for the gameCreate field.
-func (r *mutationResolver) GameCreate(ctx context.Context, typeArg model.GameType, limit resolver.TimeLimit) (*model.GameMutationResponse, error) {
+func (r *mutationResolver) GameCreate(ctx context.Context, typeArg model.GameType, limit *resolver.TimeLimit, timeControl *model.TimeControlInput) (*model.GameMutationResponse, error) {
 	userID, ok := resolver.GetAuthUserID(ctx)
 	if !ok {
 		return nil, fmt.Errorf("could not validate user")
 	}
 
 	var timeLimit game.TimeLimit
-	switch limit {
-	case resolver.BULLET:
-		timeLimit = game.BULLET
-	case resolver.BLITZ:
-		timeLimit = game.BLITZ
-	case resolver.BLITZ2:
-		timeLimit = game.BLITZ2
-	case resolver.RAPID:
-		timeLimit = game.RAPID
-	case resolver.RAPID2:
-		timeLimit = game.RAPID2
-	case resolver.RAPID3:
-		timeLimit = game.RAPID3
-	case resolver.RAPID4:
-		timeLimit = game.RAPID4
-	default:
-		return nil, fmt.Errorf("time limit not valid")
+	if limit != nil {
+		var err error
+		timeLimit, err = resolver.ParseTimeLimit(*limit)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	var gameType game.GameType
-	switch typeArg {
-	case model.GameTypeJanggi:
-		gameType = game.JANGGI
-	default:
-		return nil, fmt.Errorf("game not implemented")
+	tc := parseTimeControlInput(timeControl)
+	if tc == nil && limit == nil {
+		return nil, fmt.Errorf("either a time limit or a time control is required")
 	}
 
-	game, err := r.Services.Game.CreateGame(ctx, game.CreateGameRequest{
-		UserID:    userID,
-		TimeLimit: timeLimit,
-		Type:      gameType,
+	gameType, err := game.ParseGameType(typeArg.String())
+	if err != nil {
+		return nil, err
+	}
+
+	gameReply, err := r.Services.Game.CreateGame(ctx, game.CreateGameRequest{
+		UserID:      userID,
+		TimeLimit:   timeLimit,
+		TimeControl: tc,
+		Type:        gameType,
 	})
 	if err != nil {
+		if game.IsNotAllowedError(err) {
+			return &model.GameMutationResponse{
+				Code:    int(codes.InvalidArgument),
+				Success: false,
+				Message: err.Error(),
+			}, nil
+		}
 		return nil, err
 	}
 
+	r.notifyLobby(ctx, gameReply)
+
 	return &model.GameMutationResponse{
 		Code:    http.StatusOK,
 		Success: true,
 		Message: "game was successfully created",
-		Game:    resolver.NewGameWithData(r.Services, game),
+		Game:    resolver.NewGameWithData(r.Services, gameReply),
 	}, nil
 }
 
@@ -105,6 +193,12 @@ func (r *mutationResolver) GameJoin(ctx context.Context, id string) (*model.Game
 		return nil, err
 	}
 
+	r.notifyLobby(ctx, game)
+	r.notifyGame(ctx, game,
+		&GameMessage{Kind: PLAYER_JOINED, UserID: userID},
+		clockUpdate(game),
+	)
+
 	return &model.GameMutationResponse{
 		Code:    int(codes.OK),
 		Success: true,
@@ -125,10 +219,8 @@ func (r *mutationResolver) GameMove(ctx context.Context, id string, move string,
 		return nil, err
 	}
 
-	moveN, err := game.ParseMoveNotation(move)
-	if err != nil {
-		return nil, err
-	}
+	// the notation is checked against the variant of the game when it is played
+	moveN := game.MoveNotation(move)
 
 	gameStatus := game.INGAME
 	if status != nil {
@@ -151,22 +243,31 @@ func (r *mutationResolver) GameMove(ctx context.Context, id string, move string,
 		Move:   &moveN,
 	})
 	if err != nil {
+		if game.IsIllegalMoveError(err) || game.IsNotAllowedError(err) {
+			return &model.GameMutationResponse{
+				Code:    int(codes.InvalidArgument),
+				Success: false,
+				Message: err.Error(),
+			}, nil
+		}
+
 		return nil, err
 	}
 
-	// send move to all channels with given gameID
-	moveObservers := r.getObserverMap(gameID)
-
-	// testing
-	moveObservers.MoveObservers.Range(func(_, value interface{}) bool {
-		observer := value.(*MoveObserver)
-		log.Printf("[gameMove] -- move: %v, userID: %s", observer.Move, observer.UserID.String())
-		if observer.UserID != userID {
-			observer.Move <- resolver.NewMove(r.Services, &gameReply.Moves[len(gameReply.Moves)-1])
-		}
+	// the move is out either way, so a failure only costs the push
+	err = r.publishMoves(ctx, gameReply, played(userID, gameReply))
+	if err != nil {
+		log.Printf("could not publish move: %s", err)
+	}
 
-		return true
-	})
+	events := append(movePlayed(userID, gameReply),
+		clockUpdate(gameReply),
+		gameEnded(gameReply),
+	)
+	if gameReply.DrawOffer == userID {
+		events = append(events, &GameMessage{Kind: DRAW_OFFERED, UserID: userID})
+	}
+	r.notifyGame(ctx, gameReply, events...)
 
 	return &model.GameMutationResponse{
 		Code:    http.StatusOK,
@@ -194,13 +295,12 @@ func (r *mutationResolver) GameAbort(ctx context.Context, id string) (*model.Gam
 		Status: game.Aborted,
 	})
 	if err != nil {
-		return &model.GameMutationResponse{
-			Code:    int(codes.Internal),
-			Success: false,
-			Message: "could not abort game",
-		}, nil
+		return gameMutationError(err, "could not abort game")
 	}
 
+	r.notifyLobby(ctx, reply)
+	r.notifyGame(ctx, reply, gameEnded(reply))
+
 	return &model.GameMutationResponse{
 		Code:    http.StatusOK,
 		Success: true,
@@ -209,40 +309,117 @@ func (r *mutationResolver) GameAbort(ctx context.Context, id string) (*model.Gam
 	}, nil
 }
 
-// User is the resolver for the user field.
-func (r *queryResolver) User(ctx context.Context, id *string) (*resolver.User, error) {
-	if id != nil {
-		userID, err := format.ParseUserID(*id)
-		if err != nil {
-			return nil, err
-		}
+// GameResign is the resolver for the gameResign field.
+func (r *mutationResolver) GameResign(ctx context.Context, id string) (*model.GameMutationResponse, error) {
+	userID, ok := resolver.GetAuthUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not validate user")
+	}
 
-		return resolver.NewUser(r.Services, userID), nil
+	gameID, err := format.ParseGameID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := r.Services.Game.ResignGame(ctx, game.GameActionRequest{
+		UserID: userID,
+		GameID: gameID,
+	})
+	if err != nil {
+		return gameMutationError(err, "could not resign game")
 	}
 
+	r.notifyGame(ctx, reply, gameEnded(reply))
+
+	return &model.GameMutationResponse{
+		Code:    http.StatusOK,
+		Success: true,
+		Message: "game resigned",
+		Game:    resolver.NewGameWithData(r.Services, reply),
+	}, nil
+}
+
+// GameOfferDraw is the resolver for the gameOfferDraw field.
+func (r *mutationResolver) GameOfferDraw(ctx context.Context, id string) (*model.GameMutationResponse, error) {
 	userID, ok := resolver.GetAuthUserID(ctx)
 	if !ok {
-		return nil, errors.New("no auth user in context")
+		return nil, fmt.Errorf("could not validate user")
 	}
 
-	return resolver.NewUser(r.Services, userID), nil
+	gameID, err := format.ParseGameID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := r.Services.Game.OfferDraw(ctx, game.GameActionRequest{
+		UserID: userID,
+		GameID: gameID,
+	})
+	if err != nil {
+		return gameMutationError(err, "could not offer draw")
+	}
+
+	// offering a draw back agrees to the one of the opponent
+	status := model.GameOfferStatusOffered
+	if reply.Draw {
+		status = model.GameOfferStatusAccepted
+	}
+	r.notifyOffer(ctx, userID, reply, model.GameOfferKindDraw, status)
+
+	if reply.DrawOffer == userID {
+		r.notifyGame(ctx, reply, &GameMessage{Kind: DRAW_OFFERED, UserID: userID})
+	}
+	r.notifyGame(ctx, reply, gameEnded(reply))
+
+	return &model.GameMutationResponse{
+		Code:    http.StatusOK,
+		Success: true,
+		Message: "draw offered",
+		Game:    resolver.NewGameWithData(r.Services, reply),
+	}, nil
 }
 
-// Game is the resolver for the game field.
-func (r *queryResolver) Game(ctx context.Context, id string) (*resolver.Game, error) {
+// GameRespondDraw is the resolver for the gameRespondDraw field.
+func (r *mutationResolver) GameRespondDraw(ctx context.Context, id string, accept bool) (*model.GameMutationResponse, error) {
+	userID, ok := resolver.GetAuthUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not validate user")
+	}
+
 	gameID, err := format.ParseGameID(id)
 	if err != nil {
 		return nil, err
 	}
 
-	return resolver.NewGame(r.Services, gameID), nil
+	reply, err := r.Services.Game.RespondDraw(ctx, game.RespondOfferRequest{
+		UserID: userID,
+		GameID: gameID,
+		Accept: accept,
+	})
+	if err != nil {
+		return gameMutationError(err, "could not respond to draw")
+	}
+
+	r.notifyOffer(ctx, userID, reply, model.GameOfferKindDraw, offerStatus(accept))
+
+	if !accept {
+		r.notifyGame(ctx, reply, &GameMessage{Kind: DRAW_DECLINED, UserID: userID})
+	}
+	r.notifyGame(ctx, reply, gameEnded(reply))
+
+	return &model.GameMutationResponse{
+		Code:    http.StatusOK,
+		Success: true,
+		Message: "draw answered",
+		Game:    resolver.NewGameWithData(r.Services, reply),
+	}, nil
 }
 
-// OnMoveNew is the resolver for the onMoveNew field.
-func (r *subscriptionResolver) OnMoveNew(ctx context.Context, id string) (<-chan *resolver.Move, error) {
+// GameRequestTakeback is the resolver for the gameRequestTakeback field.
+func (r *mutationResolver) GameRequestTakeback(ctx context.Context, id string) (*model.GameMutationResponse, error) {
 	userID, ok := resolver.GetAuthUserID(ctx)
 	if !ok {
-		return nil, fmt.Errorf("could not parse user from context")
+		return nil, fmt.Errorf("could not validate user")
 	}
 
 	gameID, err := format.ParseGameID(id)
@@ -250,49 +427,1074 @@ func (r *subscriptionResolver) OnMoveNew(ctx context.Context, id string) (<-chan
 		return nil, err
 	}
 
-	mc := make(chan *resolver.Move, 1)
-	observers := r.getObserverMap(gameID)
-	observers.MoveObservers.Store(userID, &MoveObserver{
+	reply, err := r.Services.Game.RequestTakeback(ctx, game.GameActionRequest{
 		UserID: userID,
-		Move:   mc,
+		GameID: gameID,
 	})
+	if err != nil {
+		return gameMutationError(err, "could not request takeback")
+	}
 
-	go func() {
-		<-ctx.Done()
-		// delete observers
-		observers.MoveObservers.Delete(userID)
-		o, ok := r.GamesMovesMap.Load(gameID)
-		if !ok {
-			return
+	r.notifyOffer(ctx, userID, reply, model.GameOfferKindTakeback, model.GameOfferStatusOffered)
+
+	if reply.TakebackOffer == userID {
+		r.notifyGame(ctx, reply, &GameMessage{Kind: TAKEBACK_REQUESTED, UserID: userID})
+	}
+	r.notifyGame(ctx, reply, gameEnded(reply))
+
+	return &model.GameMutationResponse{
+		Code:    http.StatusOK,
+		Success: true,
+		Message: "takeback requested",
+		Game:    resolver.NewGameWithData(r.Services, reply),
+	}, nil
+}
+
+// GameRespondTakeback is the resolver for the gameRespondTakeback field.
+func (r *mutationResolver) GameRespondTakeback(ctx context.Context, id string, accept bool) (*model.GameMutationResponse, error) {
+	userID, ok := resolver.GetAuthUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not validate user")
+	}
+
+	gameID, err := format.ParseGameID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := r.Services.Game.RespondTakeback(ctx, game.RespondOfferRequest{
+		UserID: userID,
+		GameID: gameID,
+		Accept: accept,
+	})
+	if err != nil {
+		return gameMutationError(err, "could not respond to takeback")
+	}
+
+	r.notifyOffer(ctx, userID, reply, model.GameOfferKindTakeback, offerStatus(accept))
+	if accept {
+		err = r.publishTakeback(ctx, reply)
+		if err != nil {
+			log.Printf("could not publish takeback: %s", err)
 		}
+	}
+	r.notifyGame(ctx, reply,
+		&GameMessage{Kind: TAKEBACK_ANSWERED, UserID: userID, Accepted: accept},
+		clockUpdate(reply),
+		gameEnded(reply),
+	)
 
-		numObservers := 0
-		o.(*Observers).MoveObservers.Range(func(_, _ any) bool {
-			numObservers++
-			return true
-		})
+	return &model.GameMutationResponse{
+		Code:    http.StatusOK,
+		Success: true,
+		Message: "takeback answered",
+		Game:    resolver.NewGameWithData(r.Services, reply),
+	}, nil
+}
+
+// GameClaimVictory is the resolver for the gameClaimVictory field.
+func (r *mutationResolver) GameClaimVictory(ctx context.Context, id string) (*model.GameMutationResponse, error) {
+	userID, ok := resolver.GetAuthUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not validate user")
+	}
+
+	gameID, err := format.ParseGameID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// the opponent may have gone without it being noticed
+	r.findAway(ctx, gameID, userID)
+
+	reply, err := r.Services.Game.ClaimVictory(ctx, game.GameActionRequest{
+		UserID: userID,
+		GameID: gameID,
+	})
+	if err != nil {
+		return gameMutationError(err, "could not claim victory")
+	}
+
+	r.notifyGame(ctx, reply, gameEnded(reply))
+
+	return &model.GameMutationResponse{
+		Code:    http.StatusOK,
+		Success: true,
+		Message: "victory claimed",
+		Game:    resolver.NewGameWithData(r.Services, reply),
+	}, nil
+}
+
+// GameChatSend is the resolver for the gameChatSend field.
+func (r *mutationResolver) GameChatSend(ctx context.Context, gameID string, text string) (*model.ChatMutationResponse, error) {
+	userID, ok := resolver.GetAuthUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not validate user")
+	}
+
+	parsedGameID, err := format.ParseGameID(gameID)
+	if err != nil {
+		return nil, err
+	}
 
-		if numObservers == 0 {
-			r.GamesMovesMap.Delete(gameID)
+	message, err := r.Services.Chats.SendMessage(ctx, chat.SendMessageRequest{
+		UserID: userID,
+		GameID: parsedGameID,
+		Text:   text,
+	})
+	if err != nil {
+		if chat.IsNotAllowedError(err) {
+			return &model.ChatMutationResponse{
+				Code:    int(codes.InvalidArgument),
+				Success: false,
+				Message: err.Error(),
+			}, nil
 		}
-	}()
 
-	return mc, nil
+		return &model.ChatMutationResponse{
+			Code:    int(codes.Internal),
+			Success: false,
+			Message: "could not send message",
+		}, nil
+	}
+
+	// the message is kept either way, so a failure only costs the push
+	err = r.publishChat(ctx, message)
+	if err != nil {
+		log.Printf("could not publish chat message: %s", err)
+	}
+
+	return &model.ChatMutationResponse{
+		Code:        http.StatusOK,
+		Success:     true,
+		Message:     "message sent",
+		ChatMessage: newChatMessage(r.Services, message),
+	}, nil
 }
 
-// Game returns generated.GameResolver implementation.
-func (r *Resolver) Game() generated.GameResolver { return &gameResolver{r} }
+// ChatMute is the resolver for the chatMute field.
+func (r *mutationResolver) ChatMute(ctx context.Context, userID string) (*model.BasicMutationResponse, error) {
+	return r.editMutes(ctx, userID, true)
+}
 
-// Mutation returns generated.MutationResolver implementation.
-func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+// ChatUnmute is the resolver for the chatUnmute field.
+func (r *mutationResolver) ChatUnmute(ctx context.Context, userID string) (*model.BasicMutationResponse, error) {
+	return r.editMutes(ctx, userID, false)
+}
 
-// Query returns generated.QueryResolver implementation.
-func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
+// MatchmakingEnter is the resolver for the matchmakingEnter field.
+func (r *mutationResolver) MatchmakingEnter(ctx context.Context, typeArg model.GameType, limit resolver.TimeLimit) (*model.BasicMutationResponse, error) {
+	userID, ok := resolver.GetAuthUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not validate user")
+	}
 
-// Subscription returns generated.SubscriptionResolver implementation.
-func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+	timeLimit, err := resolver.ParseTimeLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	gameType, err := game.ParseGameType(typeArg.String())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.Services.Matchmaking.Enter(ctx, matchmaking.EnterRequest{
+		UserID:    userID,
+		Type:      gameType,
+		TimeLimit: timeLimit,
+	})
+	if err != nil {
+		return &model.BasicMutationResponse{
+			Code:    int(codes.Internal),
+			Success: false,
+			Message: "could not enter matchmaking",
+		}, nil
+	}
+
+	return &model.BasicMutationResponse{
+		Code:    http.StatusOK,
+		Success: true,
+		Message: "entered matchmaking",
+	}, nil
+}
+
+// MatchmakingLeave is the resolver for the matchmakingLeave field.
+func (r *mutationResolver) MatchmakingLeave(ctx context.Context) (*model.BasicMutationResponse, error) {
+	userID, ok := resolver.GetAuthUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not validate user")
+	}
+
+	reply, err := r.Services.Matchmaking.Leave(ctx, matchmaking.LeaveRequest{
+		UserID: userID,
+	})
+	if err != nil {
+		return &model.BasicMutationResponse{
+			Code:    int(codes.Internal),
+			Success: false,
+			Message: "could not leave matchmaking",
+		}, nil
+	}
+
+	if !reply.Left {
+		return &model.BasicMutationResponse{
+			Code:    int(codes.NotFound),
+			Success: false,
+			Message: "not in matchmaking",
+		}, nil
+	}
+
+	return &model.BasicMutationResponse{
+		Code:    http.StatusOK,
+		Success: true,
+		Message: "left matchmaking",
+	}, nil
+}
+
+// User is the resolver for the user field.
+func (r *queryResolver) User(ctx context.Context, id *string) (*resolver.User, error) {
+	if id != nil {
+		userID, err := format.ParseUserID(*id)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.NewUser(r.Services, userID), nil
+	}
+
+	userID, ok := resolver.GetAuthUserID(ctx)
+	if !ok {
+		return nil, errors.New("no auth user in context")
+	}
+
+	return resolver.NewUser(r.Services, userID), nil
+}
+
+// Game is the resolver for the game field.
+func (r *queryResolver) Game(ctx context.Context, id string) (*resolver.Game, error) {
+	gameID, err := format.ParseGameID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolver.NewGame(r.Services, gameID), nil
+}
+
+// Type is the resolver for the type field.
+func (r *ratingResolver) Type(ctx context.Context, obj *resolver.Rating) (*model.GameType, error) {
+	gameType, err := obj.Type(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	typeArg := model.GameType(strings.ToUpper(gameType))
+	if !typeArg.IsValid() {
+		return nil, fmt.Errorf("unknown game type: %s", gameType)
+	}
+
+	return &typeArg, nil
+}
+
+// Category is the resolver for the category field.
+func (r *ratingResolver) Category(ctx context.Context, obj *resolver.Rating) (*model.RatingCategory, error) {
+	category, err := obj.Category(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryArg := model.RatingCategory(strings.ToUpper(category))
+	if !categoryArg.IsValid() {
+		return nil, fmt.Errorf("unknown rating category: %s", category)
+	}
+
+	return &categoryArg, nil
+}
+
+// OnMoveNew is the resolver for the onMoveNew field.
+func (r *subscriptionResolver) OnMoveNew(ctx context.Context, id string, sinceMoveIndex *int) (<-chan *resolver.Move, error) {
+	// anyone not signed in is a spectator
+	userID, _ := resolver.GetAuthUserID(ctx)
+
+	gameID, err := format.ParseGameID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := r.Services.Game.GetGame(ctx, game.GetGameRequest{
+		GameID: gameID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the subscription stops with the stream, such as when it falls behind
+	ctx, cancel := context.WithCancel(ctx)
+	messages, err := r.Broker.Subscribe(ctx, moveTopic(gameID))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	next := -1
+	if sinceMoveIndex != nil {
+		next = *sinceMoveIndex
+		if next < 0 {
+			next = 0
+		}
+	}
+
+	moves := make(chan *game.MoveResponse, SUBSCRIPTION_BUFFER)
+	go func() {
+		defer close(moves)
+		defer cancel()
+
+		r.streamMoves(ctx, gameID, userID, next, messages, moves)
+	}()
+
+	r.attend(ctx, data, userID)
+
+	var delayedMoves <-chan *game.MoveResponse = moves
+	if spectator(data, userID) && r.Services.SpectatorDelay > 0 {
+		delayedMoves = delayed(ctx, delayedMoves, r.Services.SpectatorDelay, func(move *game.MoveResponse) time.Time {
+			return move.Timestamp
+		})
+	}
+
+	mc := make(chan *resolver.Move, SUBSCRIPTION_BUFFER)
+	go func() {
+		defer close(mc)
+
+		for move := range delayedMoves {
+			select {
+			case mc <- resolver.NewMove(r.Services, move):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return mc, nil
+}
+
+// ChallengeCreate is the resolver for the challengeCreate field.
+func (r *mutationResolver) ChallengeCreate(ctx context.Context, userID string, typeArg model.GameType, limit *resolver.TimeLimit, timeControl *model.TimeControlInput) (*model.ChallengeMutationResponse, error) {
+	authUserID, ok := resolver.GetAuthUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not validate user")
+	}
+
+	otherUserID, err := format.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var timeLimit game.TimeLimit
+	if limit != nil {
+		timeLimit, err = resolver.ParseTimeLimit(*limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tc := parseTimeControlInput(timeControl)
+	if tc == nil && limit == nil {
+		return nil, fmt.Errorf("either a time limit or a time control is required")
+	}
+
+	gameType, err := game.ParseGameType(typeArg.String())
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := r.Services.Challenge.CreateChallenge(ctx, challenge.CreateChallengeRequest{
+		UserID:      authUserID,
+		OtherUserID: otherUserID,
+		Type:        gameType,
+		TimeLimit:   timeLimit,
+		TimeControl: tc,
+	})
+	if err != nil {
+		return challengeMutationError(err, "could not create challenge")
+	}
+
+	r.notifyChallenge(ctx, reply.ChallengedID, reply)
+
+	return &model.ChallengeMutationResponse{
+		Code:      http.StatusOK,
+		Success:   true,
+		Message:   "challenge was successfully created",
+		Challenge: resolver.NewChallengeWithData(r.Services, reply),
+	}, nil
+}
+
+// ChallengeAccept is the resolver for the challengeAccept field.
+func (r *mutationResolver) ChallengeAccept(ctx context.Context, id string) (*model.ChallengeMutationResponse, error) {
+	userID, ok := resolver.GetAuthUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not validate user")
+	}
+
+	challengeID, err := format.ParseChallengeID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := r.Services.Challenge.AcceptChallenge(ctx, challenge.AcceptChallengeRequest{
+		UserID:      userID,
+		ChallengeID: challengeID,
+	})
+	if err != nil {
+		return challengeMutationError(err, "could not accept challenge")
+	}
+
+	r.notifyChallenge(ctx, reply.ChallengerID, reply)
+
+	return &model.ChallengeMutationResponse{
+		Code:      http.StatusOK,
+		Success:   true,
+		Message:   "challenge accepted",
+		Challenge: resolver.NewChallengeWithData(r.Services, reply),
+	}, nil
+}
+
+// ChallengeDecline is the resolver for the challengeDecline field.
+func (r *mutationResolver) ChallengeDecline(ctx context.Context, id string) (*model.ChallengeMutationResponse, error) {
+	userID, ok := resolver.GetAuthUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not validate user")
+	}
+
+	challengeID, err := format.ParseChallengeID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := r.Services.Challenge.DeclineChallenge(ctx, challenge.DeclineChallengeRequest{
+		UserID:      userID,
+		ChallengeID: challengeID,
+	})
+	if err != nil {
+		return challengeMutationError(err, "could not decline challenge")
+	}
+
+	// whoever did not answer is told about it
+	if userID == reply.ChallengerID {
+		r.notifyChallenge(ctx, reply.ChallengedID, reply)
+	} else {
+		r.notifyChallenge(ctx, reply.ChallengerID, reply)
+	}
+
+	return &model.ChallengeMutationResponse{
+		Code:      http.StatusOK,
+		Success:   true,
+		Message:   "challenge " + string(reply.Status),
+		Challenge: resolver.NewChallengeWithData(r.Services, reply),
+	}, nil
+}
+
+// RematchOffer is the resolver for the rematchOffer field.
+func (r *mutationResolver) RematchOffer(ctx context.Context, gameID string) (*model.ChallengeMutationResponse, error) {
+	userID, ok := resolver.GetAuthUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not validate user")
+	}
+
+	parsedGameID, err := format.ParseGameID(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := r.Services.Challenge.OfferRematch(ctx, challenge.OfferRematchRequest{
+		UserID: userID,
+		GameID: parsedGameID,
+	})
+	if err != nil {
+		return challengeMutationError(err, "could not offer rematch")
+	}
+
+	r.notifyChallenge(ctx, reply.ChallengedID, reply)
+
+	return &model.ChallengeMutationResponse{
+		Code:      http.StatusOK,
+		Success:   true,
+		Message:   "rematch was successfully offered",
+		Challenge: resolver.NewChallengeWithData(r.Services, reply),
+	}, nil
+}
+
+// Leaderboard is the resolver for the leaderboard field.
+func (r *queryResolver) Leaderboard(ctx context.Context, typeArg model.GameType, category model.RatingCategory, aroundMe *bool, pagination *model.Pagination) (*model.Leaderboard, error) {
+	gameType, err := game.ParseGameType(typeArg.String())
+	if err != nil {
+		return nil, err
+	}
+
+	request := elo.GetLeaderboardRequest{
+		Game:     elo.GameType(gameType),
+		Category: elo.Category(strings.ToLower(category.String())),
+	}
+
+	if aroundMe != nil && *aroundMe {
+		userID, ok := resolver.GetAuthUserID(ctx)
+		if !ok {
+			return nil, fmt.Errorf("could not validate user")
+		}
+		request.UserID = userID
+	}
+
+	if pagination != nil {
+		if pagination.Cursor != nil {
+			request.Cursor = *pagination.Cursor
+		}
+		if pagination.Limit != nil {
+			request.Limit = *pagination.Limit
+		}
+	}
+
+	leaderboard, err := r.Services.Elo.GetLeaderboard(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*model.LeaderboardEntry, 0)
+	for _, entry := range leaderboard.Entries {
+		entries = append(entries, &model.LeaderboardEntry{
+			Rank:   entry.Rank,
+			User:   resolver.NewUser(r.Services, entry.Elo.UserID),
+			Rating: resolver.NewRating(entry.Elo),
+		})
+	}
+
+	var next *string
+	if leaderboard.Next != "" {
+		next = &leaderboard.Next
+	}
+
+	return &model.Leaderboard{
+		Entries: entries,
+		Next:    next,
+	}, nil
+}
+
+// Lobby is the resolver for the lobby field.
+func (r *queryResolver) Lobby(ctx context.Context, typeArg *model.GameType, limit *resolver.TimeLimit, pagination *model.Pagination) (*model.Games, error) {
+	request := game.GetLobbyRequest{}
+
+	if typeArg != nil {
+		gameType, err := game.ParseGameType(typeArg.String())
+		if err != nil {
+			return nil, err
+		}
+		request.Type = gameType
+	}
+
+	if limit != nil {
+		timeLimit, err := resolver.ParseTimeLimit(*limit)
+		if err != nil {
+			return nil, err
+		}
+		request.TimeLimit = timeLimit
+	}
+
+	if pagination != nil {
+		if pagination.Cursor != nil {
+			request.Cursor = *pagination.Cursor
+		}
+		if pagination.Limit != nil {
+			request.Limit = *pagination.Limit
+		}
+	}
+
+	lobby, err := r.Services.Game.GetLobby(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	games := make([]*resolver.Game, 0)
+	for _, g := range lobby.Games {
+		games = append(games, resolver.NewGameWithData(r.Services, g))
+	}
+
+	var next *string
+	if lobby.Next != "" {
+		next = &lobby.Next
+	}
+
+	return &model.Games{
+		Games: games,
+		Next:  next,
+	}, nil
+}
+
+// UsersOnline is the resolver for the usersOnline field.
+func (r *queryResolver) UsersOnline(ctx context.Context, ids []string) ([]*resolver.User, error) {
+	if len(ids) > MAX_USERS_ONLINE {
+		return nil, fmt.Errorf("at most %d users can be looked up", MAX_USERS_ONLINE)
+	}
+
+	userIDs := make([]format.UserID, 0, len(ids))
+	for _, id := range ids {
+		userID, err := format.ParseUserID(id)
+		if err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	presences, err := r.Services.Presence.GetPresence(ctx, presence.GetPresenceRequest{
+		UserIDs: userIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*resolver.User, 0)
+	for _, userID := range userIDs {
+		if presences[userID].Online {
+			users = append(users, resolver.NewUser(r.Services, userID))
+		}
+	}
+
+	return users, nil
+}
+
+// OnMatchFound is the resolver for the onMatchFound field.
+func (r *subscriptionResolver) OnMatchFound(ctx context.Context) (<-chan *resolver.Game, error) {
+	userID, ok := resolver.GetAuthUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not parse user from context")
+	}
+
+	matches, err := r.Services.Matchmaking.Matches(ctx, matchmaking.MatchesRequest{
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// every subscription of the user counts, such as the ones of its
+	// other tabs or the one a reconnect opens before this one closes
+	searched, err := r.Services.Presence.Search(ctx, presence.SearchRequest{
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gc := make(chan *resolver.Game, 1)
+	go func() {
+		defer close(gc)
+
+		for match := range matches {
+			select {
+			case gc <- resolver.NewGame(r.Services, match.GameID):
+			case <-ctx.Done():
+			}
+		}
+
+		<-searched.Left
+		r.leaveMatchmaking(userID)
+	}()
+
+	return gc, nil
+}
+
+// OnLobbyChange is the resolver for the onLobbyChange field.
+func (r *subscriptionResolver) OnLobbyChange(ctx context.Context, typeArg *model.GameType) (<-chan *resolver.Game, error) {
+	_, ok := resolver.GetAuthUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not parse user from context")
+	}
+
+	var gameType game.GameType
+	if typeArg != nil {
+		parsed, err := game.ParseGameType(typeArg.String())
+		if err != nil {
+			return nil, err
+		}
+		gameType = parsed
+	}
+
+	games, err := r.Broker.Subscribe(ctx, lobbyTopic(gameType))
+	if err != nil {
+		return nil, err
+	}
+
+	gc := make(chan *resolver.Game, 1)
+	go func() {
+		defer close(gc)
+
+		r.streamLobby(games, gc)
+	}()
+
+	return gc, nil
+}
+
+// OnChallenge is the resolver for the onChallenge field.
+func (r *subscriptionResolver) OnChallenge(ctx context.Context) (<-chan *resolver.Challenge, error) {
+	userID, ok := resolver.GetAuthUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not parse user from context")
+	}
+
+	challenges, err := r.Broker.Subscribe(ctx, challengeTopic(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	cc := make(chan *resolver.Challenge, 1)
+	go func() {
+		defer close(cc)
+
+		r.streamChallenges(challenges, cc)
+	}()
+
+	return cc, nil
+}
+
+// OnGameOffer is the resolver for the onGameOffer field.
+func (r *subscriptionResolver) OnGameOffer(ctx context.Context, id string) (<-chan *model.GameOffer, error) {
+	userID, ok := resolver.GetAuthUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("could not parse user from context")
+	}
+
+	gameID, err := format.ParseGameID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	offers, err := r.Broker.Subscribe(ctx, offerTopic(gameID, userID))
+	if err != nil {
+		return nil, err
+	}
+
+	oc := make(chan *model.GameOffer, 1)
+	go func() {
+		defer close(oc)
+
+		r.streamOffers(offers, oc)
+	}()
+
+	return oc, nil
+}
+
+// OnGameUpdate is the resolver for the onGameUpdate field.
+func (r *subscriptionResolver) OnGameUpdate(ctx context.Context, id string, sinceMoveIndex *int) (<-chan model.GameEvent, error) {
+	// anyone not signed in is a spectator
+	userID, _ := resolver.GetAuthUserID(ctx)
+
+	gameID, err := format.ParseGameID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// the subscription stops with the stream, such as when it falls behind
+	ctx, cancel := context.WithCancel(ctx)
+	updates, err := r.Broker.Subscribe(ctx, gameTopic(gameID))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// subscribing first means nothing is missed between the replay and
+	// the live events, which carry the index of their move to drop twice
+	data, err := r.Services.Game.GetGame(ctx, game.GetGameRequest{
+		GameID: gameID,
+	})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	observer := &GameObserver{
+		UserID: userID,
+		GameID: gameID,
+		Event:  make(chan model.GameEvent, SUBSCRIPTION_BUFFER),
+	}
+
+	watching := spectator(data, userID)
+	if watching {
+		r.watch(ctx, gameID)
+	}
+	r.attend(ctx, data, userID)
+
+	go func() {
+		defer observer.close()
+		defer cancel()
+
+		if sinceMoveIndex != nil {
+			index := *sinceMoveIndex
+			if index < 0 {
+				index = 0
+			}
+			if !r.replayGame(ctx, observer, data, index) {
+				return
+			}
+		}
+
+		r.streamGame(updates, observer)
+	}()
+
+	if watching && r.Services.SpectatorDelay > 0 {
+		return delayed[model.GameEvent](ctx, observer.Event, r.Services.SpectatorDelay, nil), nil
+	}
+	return observer.Event, nil
+}
+
+// OnGameChat is the resolver for the onGameChat field.
+func (r *subscriptionResolver) OnGameChat(ctx context.Context, gameID string) (<-chan *model.ChatMessage, error) {
+	// anyone not signed in reads the spectators' chat
+	userID, _ := resolver.GetAuthUserID(ctx)
+
+	parsedGameID, err := format.ParseGameID(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := r.Services.Game.GetGame(ctx, game.GetGameRequest{
+		GameID: parsedGameID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	messages, err := r.Broker.Subscribe(ctx, chatTopic(parsedGameID, chat.ChannelOf(data, userID)))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// without a user there is nobody muted to keep up with
+	var mutes <-chan []byte
+	if userID != "" {
+		mutes, err = r.Broker.Subscribe(ctx, mutesTopic(userID))
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+
+	cc := make(chan *model.ChatMessage, SUBSCRIPTION_BUFFER)
+	go func() {
+		defer close(cc)
+		defer cancel()
+
+		r.streamChat(ctx, userID, messages, mutes, cc)
+	}()
+
+	return cc, nil
+}
+
+// EloHistory is the resolver for the eloHistory field.
+func (r *userResolver) EloHistory(ctx context.Context, obj *resolver.User, typeArg model.GameType, category *model.RatingCategory, from *string, to *string, pagination *model.Pagination) (*model.EloHistory, error) {
+	id, err := obj.ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gameType, err := game.ParseGameType(typeArg.String())
+	if err != nil {
+		return nil, err
+	}
+
+	request := elo.GetEloHistoryRequest{
+		UserID: format.UserID(id),
+		Game:   elo.GameType(gameType),
+	}
+
+	if category != nil {
+		request.Category = elo.Category(strings.ToLower(category.String()))
+	} else {
+		elos, err := r.Services.Elo.GetElos(ctx, elo.GetElosRequest{
+			UserID: request.UserID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		rating := resolver.MostPlayed(elos, request.Game)
+		if rating == nil {
+			return &model.EloHistory{
+				Points: make([]*model.EloPoint, 0),
+			}, nil
+		}
+		request.Category = rating.Category
+	}
+
+	if from != nil {
+		request.From, err = time.Parse(time.RFC3339, *from)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from: %w", err)
+		}
+	}
+	if to != nil {
+		request.To, err = time.Parse(time.RFC3339, *to)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to: %w", err)
+		}
+	}
+
+	if pagination != nil {
+		if pagination.Cursor != nil {
+			request.Cursor = *pagination.Cursor
+		}
+		if pagination.Limit != nil {
+			request.Limit = *pagination.Limit
+		}
+	}
+
+	history, err := r.Services.Elo.GetEloHistory(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]*model.EloPoint, 0)
+	for _, p := range history.Points {
+		point := *p
+		timestamp := point.Timestamp.Format(time.RFC3339)
+		points = append(points, &model.EloPoint{
+			Elo:       &point.Elo,
+			Deviation: &point.Deviation,
+			Delta:     &point.Delta,
+			Game:      resolver.NewGame(r.Services, point.GameID),
+			Timestamp: &timestamp,
+		})
+	}
+
+	var next *string
+	if history.Next != "" {
+		next = &history.Next
+	}
+
+	return &model.EloHistory{
+		Points: points,
+		Next:   next,
+	}, nil
+}
+
+// Games is the resolver for the games field.
+func (r *userResolver) Games(ctx context.Context, obj *resolver.User, filter *model.GameFilter, pagination *model.Pagination) (*model.Games, error) {
+	id, err := obj.ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	request := game.GetGamesRequest{
+		UserID: format.UserID(id),
+	}
+
+	if filter != nil {
+		if filter.Type != nil {
+			gameType, err := game.ParseGameType(filter.Type.String())
+			if err != nil {
+				return nil, err
+			}
+			request.Type = gameType
+		}
+
+		if filter.Result != nil {
+			switch *filter.Result {
+			case model.GameStatusWin:
+				request.Result = game.WIN
+			case model.GameStatusIngame:
+				request.Result = game.INGAME
+			case model.GameStatusLoss:
+				request.Result = game.LOSS
+			case model.GameStatusDraw:
+				request.Result = game.DRAW
+			}
+		}
+
+		if filter.Opponent != nil {
+			request.OpponentID, err = format.ParseUserID(*filter.Opponent)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if filter.TimeLimit != nil {
+			request.TimeLimit, err = resolver.ParseTimeLimit(*filter.TimeLimit)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if filter.From != nil {
+			request.From, err = time.Parse(time.RFC3339, *filter.From)
+			if err != nil {
+				return nil, fmt.Errorf("invalid from: %w", err)
+			}
+		}
+		if filter.To != nil {
+			request.To, err = time.Parse(time.RFC3339, *filter.To)
+			if err != nil {
+				return nil, fmt.Errorf("invalid to: %w", err)
+			}
+		}
+	}
+
+	if pagination != nil {
+		if pagination.Cursor != nil {
+			request.Cursor = *pagination.Cursor
+		}
+		if pagination.Limit != nil {
+			request.Limit = *pagination.Limit
+		}
+	}
+
+	reply, err := r.Services.Game.GetGames(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	games := make([]*resolver.Game, 0)
+	for _, g := range reply.Games {
+		games = append(games, resolver.NewGameWithData(r.Services, g))
+	}
+
+	var next *string
+	if reply.Next != "" {
+		next = &reply.Next
+	}
+
+	return &model.Games{
+		Games: games,
+		Next:  next,
+	}, nil
+}
+
+// Challenge returns generated.ChallengeResolver implementation.
+func (r *Resolver) Challenge() generated.ChallengeResolver { return &challengeResolver{r} }
+
+// Game returns generated.GameResolver implementation.
+func (r *Resolver) Game() generated.GameResolver { return &gameResolver{r} }
+
+// GameStats returns generated.GameStatsResolver implementation.
+func (r *Resolver) GameStats() generated.GameStatsResolver { return &gameStatsResolver{r} }
+
+// Mutation returns generated.MutationResolver implementation.
+func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+
+// Query returns generated.QueryResolver implementation.
+func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
+
+// Rating returns generated.RatingResolver implementation.
+func (r *Resolver) Rating() generated.RatingResolver { return &ratingResolver{r} }
+
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
+// User returns generated.UserResolver implementation.
+func (r *Resolver) User() generated.UserResolver { return &userResolver{r} }
 
+type challengeResolver struct{ *Resolver }
 type gameResolver struct{ *Resolver }
+type gameStatsResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type ratingResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
+type userResolver struct{ *Resolver }
@@ -0,0 +1,74 @@
+package challenge
+
+import (
+	"context"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/garlicgarrison/chessvars-backend/pkg/game"
+)
+
+type Service interface {
+	CreateChallenge(context.Context, CreateChallengeRequest) (*CreateChallengeResponse, error)
+	GetChallenge(context.Context, GetChallengeRequest) (*GetChallengeResponse, error)
+	// AcceptChallenge starts the game of the challenge with both seats filled
+	AcceptChallenge(context.Context, AcceptChallengeRequest) (*AcceptChallengeResponse, error)
+	// DeclineChallenge declines the challenge, or cancels it
+	// when done by the challenger
+	DeclineChallenge(context.Context, DeclineChallengeRequest) (*DeclineChallengeResponse, error)
+	// OfferRematch challenges the opponent of a finished game to
+	// the same game with the seats swapped
+	OfferRematch(context.Context, OfferRematchRequest) (*OfferRematchResponse, error)
+}
+
+type Challenge struct {
+	ID             format.ChallengeID `json:"challenge_id"`
+	ChallengerID   format.UserID      `json:"challenger_id"`
+	ChallengedID   format.UserID      `json:"challenged_id"`
+	Type           game.GameType      `json:"type"`
+	TimeLimit      game.TimeLimit     `json:"time_limit"`
+	TimeControl    *game.TimeControl  `json:"time_control"`
+	ChallengerSide *game.Side         `json:"challenger_side"`
+	RematchOf      format.GameID      `json:"rematch_of"`
+	Status         ChallengeStatus    `json:"status"`
+	GameID         format.GameID      `json:"game_id"`
+	ExpiresAt      time.Time          `json:"expires_at"`
+	Timestamp      time.Time          `json:"timestamp"`
+}
+
+type CreateChallengeRequest struct {
+	UserID      format.UserID     `json:"user_id"`
+	OtherUserID format.UserID     `json:"other_user_id"`
+	Type        game.GameType     `json:"type"`
+	TimeLimit   game.TimeLimit    `json:"time_limit"`
+	TimeControl *game.TimeControl `json:"time_control"`
+}
+
+type CreateChallengeResponse = Challenge
+
+type GetChallengeRequest struct {
+	ChallengeID format.ChallengeID `json:"challenge_id"`
+}
+
+type GetChallengeResponse = Challenge
+
+type AcceptChallengeRequest struct {
+	UserID      format.UserID      `json:"user_id"`
+	ChallengeID format.ChallengeID `json:"challenge_id"`
+}
+
+type AcceptChallengeResponse = Challenge
+
+type DeclineChallengeRequest struct {
+	UserID      format.UserID      `json:"user_id"`
+	ChallengeID format.ChallengeID `json:"challenge_id"`
+}
+
+type DeclineChallengeResponse = Challenge
+
+type OfferRematchRequest struct {
+	UserID format.UserID `json:"user_id"`
+	GameID format.GameID `json:"game_id"`
+}
+
+type OfferRematchResponse = Challenge
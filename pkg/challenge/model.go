@@ -0,0 +1,45 @@
+package challenge
+
+import (
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/garlicgarrison/chessvars-backend/pkg/game"
+)
+
+// CHALLENGE_EXPIRY is how long a challenge can be accepted for
+const CHALLENGE_EXPIRY = 10 * time.Minute
+
+type ChallengeStatus string
+
+const (
+	PENDING   ChallengeStatus = "pending"
+	ACCEPTED  ChallengeStatus = "accepted"
+	DECLINED  ChallengeStatus = "declined"
+	CANCELLED ChallengeStatus = "cancelled"
+	EXPIRED   ChallengeStatus = "expired"
+)
+
+type ChallengeDocument struct {
+	ID           format.ChallengeID `firestore:"id"`
+	ChallengerID format.UserID      `firestore:"challenger_id"`
+	ChallengedID format.UserID      `firestore:"challenged_id"`
+	Type         game.GameType      `firestore:"type"`
+	TimeLimit    game.TimeLimit     `firestore:"time_limit"`
+	TimeControl  *game.TimeControl  `firestore:"time_control"`
+	// ChallengerSide is the seat of the challenger, random when nil
+	ChallengerSide *game.Side `firestore:"challenger_side"`
+	// RematchOf is the game this challenge is a rematch of, if any
+	RematchOf format.GameID   `firestore:"rematch_of"`
+	Status    ChallengeStatus `firestore:"status"`
+	// GameID is the game started when the challenge was accepted
+	GameID format.GameID `firestore:"game_id"`
+	// ExpiresAt can also be used as the TTL field of the collection
+	ExpiresAt time.Time `firestore:"expires_at"`
+	Timestamp time.Time `firestore:"timestamp"`
+}
+
+// expired returns true if a pending challenge can no longer be accepted
+func (c *ChallengeDocument) expired(now time.Time) bool {
+	return c.Status == PENDING && !now.Before(c.ExpiresAt)
+}
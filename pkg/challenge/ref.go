@@ -0,0 +1,18 @@
+package challenge
+
+import (
+	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+)
+
+const (
+	FS_CHALLENGES_COLL = "challenges"
+)
+
+func (s *service) getChallengesRef() *firestore.CollectionRef {
+	return s.fs.Collection(FS_CHALLENGES_COLL)
+}
+
+func (s *service) getChallengeRef(challengeID format.ChallengeID) *firestore.DocumentRef {
+	return s.getChallengesRef().Doc(challengeID.String())
+}
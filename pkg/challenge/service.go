@@ -0,0 +1,263 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/garlicgarrison/chessvars-backend/pkg/game"
+)
+
+type Config struct {
+	Firestore firestore.Firestore
+
+	GameService game.Service
+}
+
+type service struct {
+	fs firestore.Firestore
+
+	game game.Service
+}
+
+func NewService(cfg Config) (Service, error) {
+	if cfg.Firestore == nil {
+		return nil, errors.New("firestore required")
+	}
+	if cfg.GameService == nil {
+		return nil, errors.New("game service required")
+	}
+
+	return &service{
+		fs:   cfg.Firestore,
+		game: cfg.GameService,
+	}, nil
+}
+
+func (s *service) populateChallenge(challenge *ChallengeDocument) *Challenge {
+	return &Challenge{
+		ID:             challenge.ID,
+		ChallengerID:   challenge.ChallengerID,
+		ChallengedID:   challenge.ChallengedID,
+		Type:           challenge.Type,
+		TimeLimit:      challenge.TimeLimit,
+		TimeControl:    challenge.TimeControl,
+		ChallengerSide: challenge.ChallengerSide,
+		RematchOf:      challenge.RematchOf,
+		Status:         challenge.Status,
+		GameID:         challenge.GameID,
+		ExpiresAt:      challenge.ExpiresAt,
+		Timestamp:      challenge.Timestamp,
+	}
+}
+
+func (s *service) create(ctx context.Context, challenge ChallengeDocument) (*Challenge, error) {
+	if challenge.ChallengerID == challenge.ChallengedID {
+		return nil, NewNotAllowedError(fmt.Errorf("cannot challenge yourself"))
+	}
+
+	_, err := game.GetVariant(challenge.Type)
+	if err != nil {
+		return nil, NewNotAllowedError(err)
+	}
+
+	if challenge.TimeControl != nil {
+		err = challenge.TimeControl.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	challenge.ID = format.NewChallengeID()
+	challenge.Status = PENDING
+	challenge.ExpiresAt = now.Add(CHALLENGE_EXPIRY)
+	challenge.Timestamp = now
+
+	_, err = s.getChallengeRef(challenge.ID).Create(ctx, challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.populateChallenge(&challenge), nil
+}
+
+func (s *service) CreateChallenge(ctx context.Context, request CreateChallengeRequest) (*CreateChallengeResponse, error) {
+	return s.create(ctx, ChallengeDocument{
+		ChallengerID: request.UserID,
+		ChallengedID: request.OtherUserID,
+		Type:         request.Type,
+		TimeLimit:    request.TimeLimit,
+		TimeControl:  request.TimeControl,
+	})
+}
+
+func (s *service) GetChallenge(ctx context.Context, request GetChallengeRequest) (*GetChallengeResponse, error) {
+	challengeSnap, err := s.getChallengeRef(request.ChallengeID).Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var challenge ChallengeDocument
+	err = challengeSnap.DataTo(&challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	if challenge.expired(time.Now()) {
+		challenge.Status = EXPIRED
+	}
+
+	return s.populateChallenge(&challenge), nil
+}
+
+// updateStatus moves a pending challenge to the status given by fn,
+// which can refuse the change by returning an error
+func (s *service) updateStatus(ctx context.Context, challengeID format.ChallengeID, fn func(*ChallengeDocument) (ChallengeStatus, error)) (*ChallengeDocument, error) {
+	now := time.Now()
+
+	var challenge ChallengeDocument
+	err := s.fs.RunTransaction(ctx, func(_ context.Context, t *firestore.Transaction) error {
+		challengeSnap, err := t.Get(s.getChallengeRef(challengeID))
+		if err != nil {
+			return err
+		}
+
+		err = challengeSnap.DataTo(&challenge)
+		if err != nil {
+			return err
+		}
+
+		status, err := fn(&challenge)
+		if err != nil {
+			return err
+		}
+
+		// the expiry is saved even though the change is refused
+		if challenge.expired(now) {
+			challenge.Status = EXPIRED
+		} else if challenge.Status == PENDING {
+			challenge.Status = status
+		} else {
+			return NewNotAllowedError(fmt.Errorf("challenge was already %s", challenge.Status))
+		}
+
+		return t.Set(
+			s.getChallengeRef(challengeID),
+			challenge,
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if challenge.Status == EXPIRED {
+		return nil, NewNotAllowedError(fmt.Errorf("challenge has expired"))
+	}
+
+	return &challenge, nil
+}
+
+func (s *service) AcceptChallenge(ctx context.Context, request AcceptChallengeRequest) (*AcceptChallengeResponse, error) {
+	challenge, err := s.updateStatus(ctx, request.ChallengeID, func(challenge *ChallengeDocument) (ChallengeStatus, error) {
+		if challenge.ChallengedID != request.UserID {
+			return "", NewNotAllowedError(fmt.Errorf("only the challenged user can accept"))
+		}
+		return ACCEPTED, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	/*
+		The challenge is accepted before the game is created so that
+		accepting twice cannot create two games. If the game cannot
+		be created, the challenge is left pending again.
+	*/
+	g, err := s.game.CreateGame(ctx, game.CreateGameRequest{
+		UserID:      challenge.ChallengerID,
+		OpponentID:  challenge.ChallengedID,
+		Side:        challenge.ChallengerSide,
+		TimeLimit:   challenge.TimeLimit,
+		TimeControl: challenge.TimeControl,
+		Type:        challenge.Type,
+	})
+	if err != nil {
+		challenge.Status = PENDING
+		if _, serr := s.getChallengeRef(challenge.ID).Set(ctx, challenge); serr != nil {
+			return nil, fmt.Errorf("%s, and could not reopen challenge: %s", err, serr)
+		}
+		return nil, err
+	}
+
+	challenge.GameID = g.ID
+	_, err = s.getChallengeRef(challenge.ID).Set(ctx, challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.populateChallenge(challenge), nil
+}
+
+func (s *service) DeclineChallenge(ctx context.Context, request DeclineChallengeRequest) (*DeclineChallengeResponse, error) {
+	challenge, err := s.updateStatus(ctx, request.ChallengeID, func(challenge *ChallengeDocument) (ChallengeStatus, error) {
+		switch request.UserID {
+		case challenge.ChallengedID:
+			return DECLINED, nil
+		case challenge.ChallengerID:
+			return CANCELLED, nil
+		default:
+			return "", NewNotAllowedError(fmt.Errorf("not part of the challenge"))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.populateChallenge(challenge), nil
+}
+
+func (s *service) OfferRematch(ctx context.Context, request OfferRematchRequest) (*OfferRematchResponse, error) {
+	g, err := s.game.GetGame(ctx, game.GetGameRequest{
+		GameID: request.GameID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if g.WinnerID == "" && !g.Draw {
+		return nil, NewNotAllowedError(fmt.Errorf("game has not finished"))
+	}
+
+	// the seats are swapped for the rematch
+	var side game.Side
+	var otherUserID format.UserID
+	switch request.UserID {
+	case g.PlayerOne:
+		side, otherUserID = game.PLAYER_TWO, g.PlayerTwo
+	case g.PlayerTwo:
+		side, otherUserID = game.PLAYER_ONE, g.PlayerOne
+	default:
+		return nil, NewNotAllowedError(fmt.Errorf("not a player of the game"))
+	}
+
+	// untimed games have no time control to carry over
+	var timeControl *game.TimeControl
+	if g.TimeControl != (game.TimeControl{}) {
+		tc := g.TimeControl
+		timeControl = &tc
+	}
+
+	return s.create(ctx, ChallengeDocument{
+		ChallengerID:   request.UserID,
+		ChallengedID:   otherUserID,
+		Type:           g.Type,
+		TimeLimit:      g.TimeLimit,
+		TimeControl:    timeControl,
+		ChallengerSide: &side,
+		RematchOf:      g.ID,
+	})
+}
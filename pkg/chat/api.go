@@ -0,0 +1,64 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+)
+
+type Service interface {
+	// SendMessage posts the text in the channel of the user in the game,
+	// once it has made it through the filter
+	SendMessage(context.Context, SendMessageRequest) (*SendMessageResponse, error)
+	// GetMessages lists the messages of the channel of the user in the game,
+	// oldest first, leaving out the ones of users it muted
+	GetMessages(context.Context, GetMessagesRequest) (*Messages, error)
+
+	// MuteUser hides the messages of the other user from the user in every game
+	MuteUser(context.Context, MuteUserRequest) error
+	UnmuteUser(context.Context, MuteUserRequest) error
+	// GetMuted returns the users the user has muted
+	GetMuted(context.Context, GetMutedRequest) ([]format.UserID, error)
+}
+
+type Message struct {
+	ID        string        `json:"message_id"`
+	GameID    format.GameID `json:"game_id"`
+	UserID    format.UserID `json:"user_id"`
+	Channel   Channel       `json:"channel"`
+	Text      string        `json:"text"`
+	Timestamp time.Time     `json:"timestamp"`
+}
+
+type Messages struct {
+	Messages []*Message `json:"messages"`
+	// Next is the cursor of the next page, empty on the last one
+	Next string `json:"next"`
+}
+
+type SendMessageRequest struct {
+	UserID format.UserID `json:"user_id"`
+	GameID format.GameID `json:"game_id"`
+	Text   string        `json:"text"`
+}
+
+type SendMessageResponse = Message
+
+// GetMessagesRequest is a page of the messages of the channel of the user,
+// which is the spectators' one when UserID is empty
+type GetMessagesRequest struct {
+	UserID format.UserID `json:"user_id"`
+	GameID format.GameID `json:"game_id"`
+	Limit  int           `json:"limit"`
+	Cursor string        `json:"cursor"`
+}
+
+type MuteUserRequest struct {
+	UserID      format.UserID `json:"user_id"`
+	OtherUserID format.UserID `json:"other_user_id"`
+}
+
+type GetMutedRequest struct {
+	UserID format.UserID `json:"user_id"`
+}
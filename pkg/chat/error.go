@@ -0,0 +1,14 @@
+package chat
+
+type NotAllowedError struct {
+	error
+}
+
+func NewNotAllowedError(err error) *NotAllowedError {
+	return &NotAllowedError{err}
+}
+
+func IsNotAllowedError(err error) bool {
+	_, ok := err.(*NotAllowedError)
+	return ok
+}
@@ -0,0 +1,162 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+)
+
+const (
+	MAX_MESSAGE_LENGTH = 280
+
+	// RATE_LIMIT_MESSAGES is how many messages a user can send
+	// within RATE_LIMIT_PERIOD
+	RATE_LIMIT_MESSAGES = 5
+	RATE_LIMIT_PERIOD   = 10 * time.Second
+)
+
+// Filter checks a message before it is sent, and may change its text.
+// A message that is refused returns a NotAllowedError.
+type Filter interface {
+	Filter(ctx context.Context, message *Message) error
+}
+
+// DefaultFilter is what messages go through when no filter is configured
+func DefaultFilter() Filter {
+	return NewFilters(
+		NewLengthFilter(MAX_MESSAGE_LENGTH),
+		NewRateLimitFilter(RATE_LIMIT_MESSAGES, RATE_LIMIT_PERIOD),
+	)
+}
+
+type filters []Filter
+
+// NewFilters runs the filters in order, stopping at the first refusal
+func NewFilters(fs ...Filter) Filter {
+	return filters(fs)
+}
+
+func (fs filters) Filter(ctx context.Context, message *Message) error {
+	for _, f := range fs {
+		err := f.Filter(ctx, message)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type lengthFilter struct {
+	max int
+}
+
+// NewLengthFilter refuses empty messages and the ones over max characters
+func NewLengthFilter(max int) Filter {
+	return &lengthFilter{max: max}
+}
+
+func (f *lengthFilter) Filter(_ context.Context, message *Message) error {
+	message.Text = strings.TrimSpace(message.Text)
+	if message.Text == "" {
+		return NewNotAllowedError(fmt.Errorf("message is empty"))
+	}
+	if utf8.RuneCountInString(message.Text) > f.max {
+		return NewNotAllowedError(fmt.Errorf("message is over %d characters", f.max))
+	}
+	return nil
+}
+
+type profanityFilter struct {
+	words *regexp.Regexp
+}
+
+// NewProfanityFilter masks the words of the list, regardless of case
+func NewProfanityFilter(words []string) Filter {
+	quoted := make([]string, 0)
+	for _, word := range words {
+		word = strings.TrimSpace(word)
+		if word != "" {
+			quoted = append(quoted, regexp.QuoteMeta(word))
+		}
+	}
+	if len(quoted) == 0 {
+		return NewFilters()
+	}
+
+	return &profanityFilter{
+		words: regexp.MustCompile(`(?i)\b(` + strings.Join(quoted, "|") + `)\b`),
+	}
+}
+
+func (f *profanityFilter) Filter(_ context.Context, message *Message) error {
+	message.Text = f.words.ReplaceAllStringFunc(message.Text, func(word string) string {
+		return strings.Repeat("*", utf8.RuneCountInString(word))
+	})
+	return nil
+}
+
+// rateLimitFilter keeps when the recent messages of each user were sent,
+// which is per instance
+type rateLimitFilter struct {
+	messages int
+	per      time.Duration
+
+	mu   sync.Mutex
+	sent map[format.UserID][]time.Time
+	// when users that stopped sending were last dropped from sent
+	pruned time.Time
+}
+
+// NewRateLimitFilter refuses messages of a user that has already
+// sent the number of messages within per
+func NewRateLimitFilter(messages int, per time.Duration) Filter {
+	return &rateLimitFilter{
+		messages: messages,
+		per:      per,
+		sent:     make(map[format.UserID][]time.Time),
+	}
+}
+
+func (f *rateLimitFilter) Filter(_ context.Context, message *Message) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := message.Timestamp
+	f.prune(now)
+
+	recent := make([]time.Time, 0)
+	for _, sent := range f.sent[message.UserID] {
+		if now.Sub(sent) < f.per {
+			recent = append(recent, sent)
+		}
+	}
+
+	if len(recent) >= f.messages {
+		f.sent[message.UserID] = recent
+		return NewNotAllowedError(fmt.Errorf("too many messages, slow down"))
+	}
+
+	f.sent[message.UserID] = append(recent, now)
+	return nil
+}
+
+// prune drops the users that have not sent a message within per,
+// at most once every per so that a message does not go over every user
+func (f *rateLimitFilter) prune(now time.Time) {
+	if now.Sub(f.pruned) < f.per {
+		return
+	}
+
+	for userID, sent := range f.sent {
+		if len(sent) == 0 || now.Sub(sent[len(sent)-1]) >= f.per {
+			delete(f.sent, userID)
+		}
+	}
+	f.pruned = now
+}
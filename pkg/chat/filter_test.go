@@ -0,0 +1,94 @@
+package chat
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLengthFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    string
+		allowed bool
+	}{
+		{name: "trimmed", text: "  good game ", want: "good game", allowed: true},
+		{name: "at the limit", text: strings.Repeat("가", 10), want: strings.Repeat("가", 10), allowed: true},
+		{name: "over the limit", text: strings.Repeat("a", 11), allowed: false},
+		{name: "empty", text: "   ", allowed: false},
+	}
+
+	filter := NewLengthFilter(10)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := &Message{Text: tt.text}
+			err := filter.Filter(context.Background(), message)
+			if tt.allowed {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, message.Text)
+			} else {
+				assert.True(t, IsNotAllowedError(err))
+			}
+		})
+	}
+}
+
+func TestProfanityFilter(t *testing.T) {
+	filter := NewProfanityFilter([]string{"darn", " heck "})
+
+	message := &Message{Text: "Darn it, what the heck, darnation"}
+	err := filter.Filter(context.Background(), message)
+	assert.NoError(t, err)
+	assert.Equal(t, "**** it, what the ****, darnation", message.Text)
+
+	// an empty list lets everything through
+	message = &Message{Text: "darn"}
+	err = NewProfanityFilter(nil).Filter(context.Background(), message)
+	assert.NoError(t, err)
+	assert.Equal(t, "darn", message.Text)
+}
+
+func TestRateLimitFilter(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	filter := NewRateLimitFilter(2, 10*time.Second)
+
+	send := func(userID string, at time.Duration) error {
+		return filter.Filter(context.Background(), &Message{
+			UserID:    format.UserID(userID),
+			Text:      "gl",
+			Timestamp: now.Add(at),
+		})
+	}
+
+	assert.NoError(t, send("a", 0))
+	assert.NoError(t, send("a", time.Second))
+	assert.True(t, IsNotAllowedError(send("a", 2*time.Second)))
+	// other users have their own limit
+	assert.NoError(t, send("b", 2*time.Second))
+	// the first message is out of the window
+	assert.NoError(t, send("a", 10*time.Second))
+
+	// users that stopped sending are forgotten
+	assert.NoError(t, send("c", 30*time.Second))
+	assert.Len(t, filter.(*rateLimitFilter).sent, 1)
+}
+
+func TestFilters(t *testing.T) {
+	filter := NewFilters(
+		NewLengthFilter(10),
+		NewProfanityFilter([]string{"darn"}),
+	)
+
+	message := &Message{Text: " darn "}
+	assert.NoError(t, filter.Filter(context.Background(), message))
+	assert.Equal(t, "****", message.Text)
+
+	message = &Message{Text: strings.Repeat("darn ", 3)}
+	assert.True(t, IsNotAllowedError(filter.Filter(context.Background(), message)))
+	assert.Equal(t, strings.TrimSpace(strings.Repeat("darn ", 3)), message.Text)
+}
@@ -0,0 +1,58 @@
+package chat
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/garlicgarrison/chessvars-backend/pkg/game"
+)
+
+// Channel keeps what the players say apart from what the spectators
+// say, so that nobody watching can help a player along
+type Channel string
+
+const (
+	PLAYERS    Channel = "players"
+	SPECTATORS Channel = "spectators"
+)
+
+// ChannelOf returns the channel the user talks and listens in,
+// which is the spectators' one for anyone not playing the game
+func ChannelOf(g *game.Game, userID format.UserID) Channel {
+	if userID != "" && (userID == g.PlayerOne || userID == g.PlayerTwo) {
+		return PLAYERS
+	}
+	return SPECTATORS
+}
+
+type MessageDocument struct {
+	ID        string        `firestore:"id"`
+	GameID    format.GameID `firestore:"game_id"`
+	UserID    format.UserID `firestore:"user_id"`
+	Channel   Channel       `firestore:"channel"`
+	Text      string        `firestore:"text"`
+	Timestamp time.Time     `firestore:"timestamp"`
+}
+
+// messageID is the id of a message, which sorts
+// in the order the messages were sent
+func messageID(timestamp time.Time, userID format.UserID) string {
+	return fmt.Sprintf("%020d_%s", timestamp.UnixNano(), userID)
+}
+
+// MutesDocument is who a user has muted
+type MutesDocument struct {
+	UserID format.UserID   `firestore:"user_id"`
+	Muted  []format.UserID `firestore:"muted"`
+}
+
+// muted returns true if the user is in muted
+func muted(muted []format.UserID, userID format.UserID) bool {
+	for _, m := range muted {
+		if m == userID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+package chat
+
+import (
+	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/garlicgarrison/chessvars-backend/pkg/game"
+)
+
+const (
+	FS_CHAT_COLL  = "chat"
+	FS_MUTES_COLL = "mutes"
+)
+
+// getChatRef is the chat of the game, kept under the game document
+func (s *service) getChatRef(gameID format.GameID) *firestore.CollectionRef {
+	return s.fs.Collection(game.FS_GAMES_COLL).
+		Doc(gameID.String()).
+		Collection(FS_CHAT_COLL)
+}
+
+func (s *service) getMessageRef(gameID format.GameID, messageID string) *firestore.DocumentRef {
+	return s.getChatRef(gameID).Doc(messageID)
+}
+
+func (s *service) getMutesRef(userID format.UserID) *firestore.DocumentRef {
+	return s.fs.Collection(FS_MUTES_COLL).Doc(userID.String())
+}
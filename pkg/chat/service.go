@@ -0,0 +1,254 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/garlicgarrison/chessvars-backend/pkg/game"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DEFAULT_MESSAGES_LIMIT = 50
+	MAX_MESSAGES_LIMIT     = 200
+)
+
+type Config struct {
+	Firestore firestore.Firestore
+
+	GameService game.Service
+
+	// Filter checks every message that is sent, DefaultFilter when nil
+	Filter Filter
+}
+
+type service struct {
+	fs firestore.Firestore
+
+	game   game.Service
+	filter Filter
+}
+
+func NewService(cfg Config) (Service, error) {
+	if cfg.Firestore == nil {
+		return nil, errors.New("firestore required")
+	}
+	if cfg.GameService == nil {
+		return nil, errors.New("game service required")
+	}
+
+	filter := cfg.Filter
+	if filter == nil {
+		filter = DefaultFilter()
+	}
+
+	return &service{
+		fs:     cfg.Firestore,
+		game:   cfg.GameService,
+		filter: filter,
+	}, nil
+}
+
+func (s *service) populateMessage(message *MessageDocument) *Message {
+	return &Message{
+		ID:        message.ID,
+		GameID:    message.GameID,
+		UserID:    message.UserID,
+		Channel:   message.Channel,
+		Text:      message.Text,
+		Timestamp: message.Timestamp,
+	}
+}
+
+func (s *service) SendMessage(ctx context.Context, request SendMessageRequest) (*SendMessageResponse, error) {
+	if request.UserID == "" {
+		return nil, NewNotAllowedError(fmt.Errorf("sign in to chat"))
+	}
+
+	g, err := s.game.GetGame(ctx, game.GetGameRequest{
+		GameID: request.GameID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	message := &Message{
+		ID:        messageID(now, request.UserID),
+		GameID:    request.GameID,
+		UserID:    request.UserID,
+		Channel:   ChannelOf(g, request.UserID),
+		Text:      request.Text,
+		Timestamp: now,
+	}
+
+	err = s.filter.Filter(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.getMessageRef(message.GameID, message.ID).Create(ctx, MessageDocument{
+		ID:        message.ID,
+		GameID:    message.GameID,
+		UserID:    message.UserID,
+		Channel:   message.Channel,
+		Text:      message.Text,
+		Timestamp: message.Timestamp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+func (s *service) GetMessages(ctx context.Context, request GetMessagesRequest) (*Messages, error) {
+	limit := request.Limit
+	if limit <= 0 {
+		limit = DEFAULT_MESSAGES_LIMIT
+	} else if limit > MAX_MESSAGES_LIMIT {
+		limit = MAX_MESSAGES_LIMIT
+	}
+
+	g, err := s.game.GetGame(ctx, game.GetGameRequest{
+		GameID: request.GameID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mutes := make([]format.UserID, 0)
+	if request.UserID != "" {
+		mutes, err = s.GetMuted(ctx, GetMutedRequest{UserID: request.UserID})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the ids of the messages sort in the order they were sent
+	query := s.getChatRef(request.GameID).
+		Where("channel", "==", ChannelOf(g, request.UserID)).
+		OrderBy(firestore.DocumentID, firestore.Asc)
+
+	if request.Cursor != "" {
+		cursor, err := firestore.DecodeCursor(request.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		if cursor != nil && cursor.Current != nil {
+			query = query.StartAt(*cursor.Current)
+		}
+	}
+
+	// the extra message is the first one of the next page
+	messageSnaps, err := query.
+		Limit(limit + 1).
+		Documents(ctx).
+		GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*Message, 0)
+	var next string
+	for i, messageSnap := range messageSnaps {
+		if i == limit {
+			current := messageSnap.Ref.ID
+			cursor := firestore.Cursor{
+				Current: &current,
+			}
+			next = cursor.Encode()
+			break
+		}
+
+		var message MessageDocument
+		err = messageSnap.DataTo(&message)
+		if err != nil {
+			return nil, err
+		}
+
+		if muted(mutes, message.UserID) {
+			continue
+		}
+		messages = append(messages, s.populateMessage(&message))
+	}
+
+	return &Messages{
+		Messages: messages,
+		Next:     next,
+	}, nil
+}
+
+func (s *service) MuteUser(ctx context.Context, request MuteUserRequest) error {
+	if request.UserID == request.OtherUserID {
+		return NewNotAllowedError(fmt.Errorf("cannot mute yourself"))
+	}
+
+	return s.editMutes(ctx, request.UserID, func(mutes []format.UserID) []format.UserID {
+		if muted(mutes, request.OtherUserID) {
+			return mutes
+		}
+		return append(mutes, request.OtherUserID)
+	})
+}
+
+func (s *service) UnmuteUser(ctx context.Context, request MuteUserRequest) error {
+	return s.editMutes(ctx, request.UserID, func(mutes []format.UserID) []format.UserID {
+		kept := make([]format.UserID, 0)
+		for _, m := range mutes {
+			if m != request.OtherUserID {
+				kept = append(kept, m)
+			}
+		}
+		return kept
+	})
+}
+
+// editMutes changes who the user has muted with fn
+func (s *service) editMutes(ctx context.Context, userID format.UserID, fn func([]format.UserID) []format.UserID) error {
+	ref := s.getMutesRef(userID)
+	return s.fs.RunTransaction(ctx, func(ctx context.Context, t *firestore.Transaction) error {
+		mutes := MutesDocument{
+			UserID: userID,
+			Muted:  make([]format.UserID, 0),
+		}
+
+		snap, err := t.Get(ref)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err == nil {
+			err = snap.DataTo(&mutes)
+			if err != nil {
+				return err
+			}
+		}
+
+		mutes.Muted = fn(mutes.Muted)
+		return t.Set(ref, mutes)
+	})
+}
+
+func (s *service) GetMuted(ctx context.Context, request GetMutedRequest) ([]format.UserID, error) {
+	snap, err := s.getMutesRef(request.UserID).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return []format.UserID{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var mutes MutesDocument
+	err = snap.DataTo(&mutes)
+	if err != nil {
+		return nil, err
+	}
+
+	return mutes.Muted, nil
+}
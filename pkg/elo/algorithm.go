@@ -0,0 +1,101 @@
+package elo
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	ELO_ALGORITHM     = "elo"
+	GLICKO2_ALGORITHM = "glicko2"
+)
+
+const (
+	K_FACTOR       = 32
+	ELO_DIFFERENCE = 400
+	// an elo is provisional until the player has this many rated games
+	ELO_PROVISIONAL_GAMES = 10
+)
+
+// Rating is the strength of a player as kept by an Algorithm
+type Rating struct {
+	Elo int
+	// Deviation and Volatility are only kept by algorithms that use them
+	Deviation  float64
+	Volatility float64
+	Games      int
+	// LastPlayed is the time of the last rated game, zero if there is none
+	LastPlayed time.Time
+}
+
+// Algorithm is a rating system used to rate the players of a game.
+type Algorithm interface {
+	// Initial returns the rating of a player that has never played
+	Initial() Rating
+	// Current returns the rating as it stands at now, without a new game
+	Current(rating Rating, now time.Time) Rating
+	// Rate returns the rating of a player after a game against other
+	Rate(rating, other Rating, status GameStatus, now time.Time) Rating
+	// Provisional reports whether the rating is still too uncertain to trust
+	Provisional(Rating) bool
+}
+
+// NewAlgorithm returns the rating algorithm called name
+func NewAlgorithm(name string) (Algorithm, error) {
+	switch name {
+	case ELO_ALGORITHM:
+		return eloAlgorithm{}, nil
+	case GLICKO2_ALGORITHM:
+		return glicko2{}, nil
+	default:
+		return nil, fmt.Errorf("unknown rating algorithm: %s", name)
+	}
+}
+
+// eloAlgorithm is plain elo with a fixed K_FACTOR
+type eloAlgorithm struct{}
+
+func (eloAlgorithm) Initial() Rating {
+	return Rating{Elo: DEFAULT_ELO}
+}
+
+func (eloAlgorithm) Current(rating Rating, _ time.Time) Rating {
+	return rating
+}
+
+func (eloAlgorithm) Rate(rating, other Rating, status GameStatus, now time.Time) Rating {
+	rating.Elo = newElo(rating.Elo, other.Elo, status)
+	rating.Games++
+	rating.LastPlayed = now
+	return rating
+}
+
+func (eloAlgorithm) Provisional(rating Rating) bool {
+	return rating.Games < ELO_PROVISIONAL_GAMES
+}
+
+// expected returns the expected score of a player rated elo
+// against a player rated otherElo
+func expected(elo, otherElo int) float64 {
+	transformR1 := math.Pow(10, float64(elo)/float64(ELO_DIFFERENCE))
+	transformR2 := math.Pow(10, float64(otherElo)/float64(ELO_DIFFERENCE))
+	return transformR1 / (transformR1 + transformR2)
+}
+
+// score returns the points of a player for the status of its game
+func score(status GameStatus) float64 {
+	switch status {
+	case WIN:
+		return 1
+	case DRAW:
+		return 0.5
+	default:
+		return 0
+	}
+}
+
+// newElo returns the rating of a player after a game against otherElo
+func newElo(elo, otherElo int, status GameStatus) int {
+	return int(math.Round(float64(elo) + float64(K_FACTOR)*(score(status)-expected(elo, otherElo))))
+}
@@ -0,0 +1,30 @@
+package elo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewElo(t *testing.T) {
+	tests := []struct {
+		name      string
+		elo       int
+		otherElo  int
+		status    GameStatus
+		want      int
+		wantOther int
+	}{
+		{name: "even win", elo: 1200, otherElo: 1200, status: WIN, want: 1216, wantOther: 1184},
+		{name: "even draw", elo: 1200, otherElo: 1200, status: DRAW, want: 1200, wantOther: 1200},
+		{name: "upset", elo: 1200, otherElo: 1600, status: WIN, want: 1229, wantOther: 1571},
+		{name: "expected loss", elo: 1200, otherElo: 1600, status: LOSS, want: 1197, wantOther: 1603},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, newElo(tt.elo, tt.otherElo, tt.status))
+			assert.Equal(t, tt.wantOther, newElo(tt.otherElo, tt.elo, opposite(tt.status)))
+		})
+	}
+}
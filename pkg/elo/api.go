@@ -2,7 +2,9 @@ package elo
 
 import (
 	"context"
+	"time"
 
+	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
 	"github.com/garlicgarrison/chessvars-backend/pkg/format"
 )
 
@@ -10,19 +12,36 @@ type Service interface {
 	CreateElo(context.Context, CreateEloRequest) (*CreateEloResponse, error)
 	GetElo(context.Context, GetEloRequest) (*GetEloResponse, error)
 	GetElos(context.Context, GetElosRequest) (*Elos, error)
-	UpdateElo(context.Context, UpdateEloRequest) (*UpdateEloResponse, error)
+	// UpdateElos rates both players of a finished game in t, which has
+	// to be the transaction that records the result before any of its writes
+	UpdateElos(context.Context, *firestore.Transaction, UpdateElosRequest) (*UpdateElosResponse, error)
+	// GetEloHistory returns the elo of the user after each rated game, oldest first
+	GetEloHistory(context.Context, GetEloHistoryRequest) (*EloHistory, error)
+	// SyncElos copies the current elos of the users to the leaderboard,
+	// to be called once the transaction given to UpdateElos has committed
+	SyncElos(context.Context, SyncElosRequest) error
+	// GetLeaderboard returns the players of a variant and category from the
+	// highest rated, or the page around UserID when it is given
+	GetLeaderboard(context.Context, GetLeaderboardRequest) (*Leaderboard, error)
+	// MigrateElos carries the ratings kept before they were split by category
+	// over to LEGACY_CATEGORY unless the user is rated in it already, along
+	// with their history, meant to be run once as a backfill
+	MigrateElos(context.Context, MigrateElosRequest) (*MigrateElosResponse, error)
 }
 
 type CreateEloRequest struct {
-	UserID format.UserID `json:"user_id"`
-	Game   GameType      `json:"game"`
+	UserID   format.UserID `json:"user_id"`
+	Game     GameType      `json:"game"`
+	Category Category      `json:"category"`
 }
 
 type GetEloRequest struct {
-	UserID format.UserID `json:"user_id"`
-	Game   GameType      `json:"game"`
+	UserID   format.UserID `json:"user_id"`
+	Game     GameType      `json:"game"`
+	Category Category      `json:"category"`
 }
 
+// GetElosRequest returns every variant and category the user was rated in
 type GetElosRequest struct {
 	UserID format.UserID `json:"user_id"`
 }
@@ -31,19 +50,92 @@ type Elos struct {
 	Elos []*Elo `json:"elos"`
 }
 
-type UpdateEloRequest struct {
+type UpdateElosRequest struct {
+	GameID      format.GameID `json:"game_id"`
 	UserID      format.UserID `json:"user_id"`
 	OtherUserID format.UserID `json:"other_user_id"`
 	Game        GameType      `json:"game"`
-	Status      GameStatus    `json:"status"`
+	Category    Category      `json:"category"`
+	// Status is the result of UserID
+	Status GameStatus `json:"status"`
+}
+
+type UpdateElosResponse struct {
+	Elo        *Elo `json:"elo"`
+	OtherElo   *Elo `json:"other_elo"`
+	Delta      int  `json:"delta"`
+	OtherDelta int  `json:"other_delta"`
+}
+
+type GetEloHistoryRequest struct {
+	UserID   format.UserID `json:"user_id"`
+	Game     GameType      `json:"game"`
+	Category Category      `json:"category"`
+	// From and To bound the games rated in [From, To), zero is unbounded
+	From   time.Time `json:"from"`
+	To     time.Time `json:"to"`
+	Cursor string    `json:"cursor"`
+	Limit  int       `json:"limit"`
+}
+
+type EloHistory struct {
+	Points []*EloPoint `json:"points"`
+	Next   string      `json:"next"`
+}
+
+// EloPoint is the elo of a user after the game GameID
+type EloPoint struct {
+	Elo       int           `json:"elo"`
+	Deviation float64       `json:"deviation"`
+	Delta     int           `json:"delta"`
+	GameID    format.GameID `json:"game_id"`
+	Timestamp time.Time     `json:"timestamp"`
+}
+
+type SyncElosRequest struct {
+	UserIDs  []format.UserID `json:"user_ids"`
+	Game     GameType        `json:"game"`
+	Category Category        `json:"category"`
+}
+
+type MigrateElosRequest struct{}
+
+type MigrateElosResponse struct {
+	// Ratings is how many ratings were carried over to a category,
+	// and Points how many history entries
+	Users   int `json:"users"`
+	Ratings int `json:"ratings"`
+	Points  int `json:"points"`
+}
+
+type GetLeaderboardRequest struct {
+	Game     GameType      `json:"game"`
+	Category Category      `json:"category"`
+	UserID   format.UserID `json:"user_id"`
+	Cursor   string        `json:"cursor"`
+	Limit    int           `json:"limit"`
+}
+
+type Leaderboard struct {
+	Entries []*LeaderboardEntry `json:"entries"`
+	Next    string              `json:"next"`
+}
+
+type LeaderboardEntry struct {
+	Rank int  `json:"rank"`
+	Elo  *Elo `json:"elo"`
 }
 
 type Elo struct {
-	UserID format.UserID `json:"user_id"`
-	Game   GameType      `json:"game"`
-	Elo    int           `json:"elo"`
+	UserID   format.UserID `json:"user_id"`
+	Game     GameType      `json:"game"`
+	Category Category      `json:"category"`
+	Elo      int           `json:"elo"`
+	Games    int           `json:"games"`
+	// Deviation is zero for algorithms that do not keep one
+	Deviation   float64 `json:"deviation"`
+	Provisional bool    `json:"provisional"`
 }
 
 type CreateEloResponse = Elo
-type UpdateEloResponse = Elo
 type GetEloResponse = Elo
@@ -0,0 +1,151 @@
+package elo
+
+import (
+	"math"
+	"time"
+)
+
+/*
+Glicko-2 as described by Mark Glickman in
+http://www.glicko.net/glicko/glicko2.pdf
+
+Every game is rated as a rating period of its own, and the deviation
+of a player grows for every GLICKO_RATING_PERIOD it has not played.
+*/
+const (
+	GLICKO_SCALE              = 173.7178
+	GLICKO_DEFAULT_DEVIATION  = 350
+	GLICKO_DEFAULT_VOLATILITY = 0.06
+	// GLICKO_TAU constrains the change in volatility over time
+	GLICKO_TAU     = 0.5
+	GLICKO_EPSILON = 0.000001
+
+	GLICKO_RATING_PERIOD = 7 * 24 * time.Hour
+	// a rating is provisional while its deviation is above this
+	GLICKO_PROVISIONAL_DEVIATION = 110
+)
+
+type glicko2 struct{}
+
+// glickoResult is a game against an opponent within a rating period
+type glickoResult struct {
+	opponent Rating
+	score    float64
+}
+
+func (glicko2) Initial() Rating {
+	return Rating{
+		Elo:        DEFAULT_ELO,
+		Deviation:  GLICKO_DEFAULT_DEVIATION,
+		Volatility: GLICKO_DEFAULT_VOLATILITY,
+	}
+}
+
+func (g glicko2) Current(rating Rating, now time.Time) Rating {
+	// ratings kept by plain elo have no deviation to start from
+	if rating.Deviation <= 0 {
+		rating.Deviation = GLICKO_DEFAULT_DEVIATION
+	}
+	if rating.Volatility <= 0 {
+		rating.Volatility = GLICKO_DEFAULT_VOLATILITY
+	}
+	if rating.LastPlayed.IsZero() || !now.After(rating.LastPlayed) {
+		return rating
+	}
+
+	periods := float64(now.Sub(rating.LastPlayed)) / float64(GLICKO_RATING_PERIOD)
+	phi := rating.Deviation / GLICKO_SCALE
+	phi = math.Sqrt(phi*phi + periods*rating.Volatility*rating.Volatility)
+	rating.Deviation = math.Min(phi*GLICKO_SCALE, GLICKO_DEFAULT_DEVIATION)
+	return rating
+}
+
+func (g glicko2) Rate(rating, other Rating, status GameStatus, now time.Time) Rating {
+	rating = g.update(g.Current(rating, now), []glickoResult{{
+		opponent: g.Current(other, now),
+		score:    score(status),
+	}})
+	rating.Games++
+	rating.LastPlayed = now
+	return rating
+}
+
+func (glicko2) Provisional(rating Rating) bool {
+	return rating.Deviation > GLICKO_PROVISIONAL_DEVIATION
+}
+
+// glickoG weighs down the result against an opponent of uncertain rating
+func glickoG(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
+
+// glickoE is the expected score against an opponent
+func glickoE(mu, muJ, phiJ float64) float64 {
+	return 1 / (1 + math.Exp(-glickoG(phiJ)*(mu-muJ)))
+}
+
+// update rates a player over a rating period of results
+func (glicko2) update(rating Rating, results []glickoResult) Rating {
+	if len(results) == 0 {
+		return rating
+	}
+
+	mu := (float64(rating.Elo) - float64(DEFAULT_ELO)) / GLICKO_SCALE
+	phi := rating.Deviation / GLICKO_SCALE
+	sigma := rating.Volatility
+
+	var vInv, sum float64
+	for _, result := range results {
+		muJ := (float64(result.opponent.Elo) - float64(DEFAULT_ELO)) / GLICKO_SCALE
+		phiJ := result.opponent.Deviation / GLICKO_SCALE
+
+		g := glickoG(phiJ)
+		e := glickoE(mu, muJ, phiJ)
+		vInv += g * g * e * (1 - e)
+		sum += g * (result.score - e)
+	}
+	v := 1 / vInv
+	delta := v * sum
+
+	// the new volatility is found with the Illinois algorithm
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		return ex*(delta*delta-phi*phi-v-ex)/(2*math.Pow(phi*phi+v+ex, 2)) -
+			(x-a)/(GLICKO_TAU*GLICKO_TAU)
+	}
+
+	A := a
+	var B float64
+	if delta*delta > phi*phi+v {
+		B = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*GLICKO_TAU) < 0 {
+			k++
+		}
+		B = a - k*GLICKO_TAU
+	}
+
+	fA, fB := f(A), f(B)
+	for math.Abs(B-A) > GLICKO_EPSILON {
+		C := A + (A-B)*fA/(fB-fA)
+		fC := f(C)
+		if fC*fB <= 0 {
+			A, fA = B, fB
+		} else {
+			fA /= 2
+		}
+		B, fB = C, fC
+	}
+	newSigma := math.Exp(A / 2)
+
+	phiStar := math.Sqrt(phi*phi + newSigma*newSigma)
+	newPhi := 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	newMu := mu + newPhi*newPhi*sum
+
+	rating.Elo = int(math.Round(newMu*GLICKO_SCALE + float64(DEFAULT_ELO)))
+	rating.Deviation = newPhi * GLICKO_SCALE
+	rating.Volatility = newSigma
+	return rating
+}
@@ -0,0 +1,104 @@
+package elo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGlicko2Update is the example of the Glicko-2 paper,
+// shifted from its 1500 default to DEFAULT_ELO
+func TestGlicko2Update(t *testing.T) {
+	player := Rating{Elo: DEFAULT_ELO, Deviation: 200, Volatility: 0.06}
+	rating := glicko2{}.update(player, []glickoResult{
+		{opponent: Rating{Elo: DEFAULT_ELO - 100, Deviation: 30}, score: 1},
+		{opponent: Rating{Elo: DEFAULT_ELO + 50, Deviation: 100}, score: 0},
+		{opponent: Rating{Elo: DEFAULT_ELO + 200, Deviation: 300}, score: 0},
+	})
+
+	assert.Equal(t, DEFAULT_ELO-36, rating.Elo)
+	assert.InDelta(t, 151.52, rating.Deviation, 0.01)
+	assert.InDelta(t, 0.05999, rating.Volatility, 0.00001)
+}
+
+func TestGlicko2Rate(t *testing.T) {
+	g := glicko2{}
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		rating      Rating
+		other       Rating
+		status      GameStatus
+		wantElo     int
+		provisional bool
+	}{
+		{
+			name:        "new players win",
+			rating:      g.Initial(),
+			other:       g.Initial(),
+			status:      WIN,
+			wantElo:     1362,
+			provisional: true,
+		},
+		{
+			name:        "new players draw",
+			rating:      g.Initial(),
+			other:       g.Initial(),
+			status:      DRAW,
+			wantElo:     DEFAULT_ELO,
+			provisional: true,
+		},
+		{
+			name:    "settled player barely moves",
+			rating:  Rating{Elo: DEFAULT_ELO, Deviation: 50, Volatility: 0.06, LastPlayed: now},
+			other:   g.Initial(),
+			status:  WIN,
+			wantElo: 1205,
+		},
+		{
+			name:        "plain elo rating starts uncertain",
+			rating:      Rating{Elo: DEFAULT_ELO, Games: 40},
+			other:       g.Initial(),
+			status:      WIN,
+			wantElo:     1362,
+			provisional: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rating := g.Rate(tt.rating, tt.other, tt.status, now)
+			assert.Equal(t, tt.wantElo, rating.Elo)
+			assert.Equal(t, tt.provisional, g.Provisional(rating))
+			assert.Equal(t, tt.rating.Games+1, rating.Games)
+			assert.Equal(t, now, rating.LastPlayed)
+		})
+	}
+}
+
+func TestGlicko2Inactivity(t *testing.T) {
+	g := glicko2{}
+	now := time.Now()
+	rating := Rating{Elo: DEFAULT_ELO, Deviation: 50, Volatility: 0.06, LastPlayed: now}
+
+	assert.Equal(t, rating, g.Current(rating, now))
+
+	year := g.Current(rating, now.Add(52*GLICKO_RATING_PERIOD))
+	assert.InDelta(t, 90.27, year.Deviation, 0.01)
+	assert.Equal(t, DEFAULT_ELO, year.Elo)
+
+	forever := g.Current(rating, now.Add(100*52*GLICKO_RATING_PERIOD))
+	assert.Equal(t, float64(GLICKO_DEFAULT_DEVIATION), forever.Deviation)
+}
+
+func TestNewAlgorithm(t *testing.T) {
+	for _, name := range []string{ELO_ALGORITHM, GLICKO2_ALGORITHM} {
+		_, err := NewAlgorithm(name)
+		assert.NoError(t, err)
+	}
+
+	_, err := NewAlgorithm("trueskill")
+	assert.Error(t, err)
+}
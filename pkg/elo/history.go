@@ -0,0 +1,90 @@
+package elo
+
+import (
+	"context"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
+)
+
+const (
+	DEFAULT_HISTORY_LIMIT = 100
+	MAX_HISTORY_LIMIT     = 1000
+)
+
+func (s *service) GetEloHistory(ctx context.Context, request GetEloHistoryRequest) (*EloHistory, error) {
+	limit := request.Limit
+	if limit <= 0 {
+		limit = DEFAULT_HISTORY_LIMIT
+	} else if limit > MAX_HISTORY_LIMIT {
+		limit = MAX_HISTORY_LIMIT
+	}
+
+	/*
+		The ids of the history sort in the order the games were rated,
+		so the time range and the pages are both cursors on the id.
+	*/
+	query := s.getEloHistoryRef(request.UserID, request.Game, request.Category).
+		OrderBy(firestore.DocumentID, firestore.Asc)
+
+	start := ""
+	if !request.From.IsZero() {
+		start = historyBound(request.From)
+	}
+	if request.Cursor != "" {
+		cursor, err := firestore.DecodeCursor(request.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		if cursor != nil && cursor.Current != nil && *cursor.Current > start {
+			start = *cursor.Current
+		}
+	}
+	if start != "" {
+		query = query.StartAt(start)
+	}
+	if !request.To.IsZero() {
+		query = query.EndBefore(historyBound(request.To))
+	}
+
+	// the extra entry is the first one of the next page
+	eloSnaps, err := query.
+		Limit(limit + 1).
+		Documents(ctx).
+		GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]*EloPoint, 0)
+	var next string
+	for i, eloSnap := range eloSnaps {
+		if i == limit {
+			current := eloSnap.Ref.ID
+			cursor := firestore.Cursor{
+				Current: &current,
+			}
+			next = cursor.Encode()
+			break
+		}
+
+		var elo EloDocument
+		err = eloSnap.DataTo(&elo)
+		if err != nil {
+			return nil, err
+		}
+
+		points = append(points, &EloPoint{
+			Elo:       elo.Elo,
+			Deviation: elo.Deviation,
+			Delta:     elo.Delta,
+			GameID:    elo.GameID,
+			Timestamp: elo.Timestamp,
+		})
+	}
+
+	return &EloHistory{
+		Points: points,
+		Next:   next,
+	}, nil
+}
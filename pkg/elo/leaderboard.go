@@ -0,0 +1,263 @@
+package elo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/elasticsearch/index"
+)
+
+const (
+	RATINGS_INDEX = "ratings"
+
+	DEFAULT_LEADERBOARD_LIMIT = 20
+	MAX_LEADERBOARD_LIMIT     = 100
+)
+
+const RATINGS_MAPPING = `{
+	"mappings": {
+		"properties": {
+			"user_id": {"type": "keyword"},
+			"game": {"type": "keyword"},
+			"category": {"type": "keyword"},
+			"elo": {"type": "integer"},
+			"deviation": {"type": "float"},
+			"games": {"type": "integer"},
+			"provisional": {"type": "boolean"},
+			"timestamp": {"type": "date"}
+		}
+	}
+}`
+
+// leaderboardQuery matches the players of a variant and category
+// whose rating is no longer provisional, along with any extra filter
+const leaderboardQuery = `{
+	"bool": {
+		"filter": [
+			{"term": {"game": %q}},
+			{"term": {"category": %q}},
+			{"term": {"provisional": false}}%s
+		]
+	}
+}`
+
+// aboveFilter matches the players ranked above elo and userID
+const aboveFilter = `,
+			{"bool": {
+				"should": [
+					{"range": {"elo": {"gt": %d}}},
+					{"bool": {"filter": [
+						{"term": {"elo": %d}},
+						{"range": {"user_id": {"lt": %q}}}
+					]}}
+				],
+				"minimum_should_match": 1
+			}}`
+
+// leaderboardRequest sorts players by elo and then by user id, which is
+// unique, so the order is total and search_after can page through it
+// without a point in time. Sorting the other way round walks up instead.
+const leaderboardRequest = `{
+	"query": %s,
+	"sort": [
+		{"elo": %q},
+		{"user_id": %q}
+	],
+	"size": %d%s
+}`
+
+const leaderboardCountRequest = `{
+	"query": %s,
+	"size": 0,
+	"track_total_hits": true
+}`
+
+func (s *service) indexElo(ctx context.Context, e EloDocument) error {
+	if s.index == nil {
+		return nil
+	}
+
+	elo := s.populateElo(e)
+	return s.index.Upsert(ctx, ratingID(e.UserID, e.GameType, e.Category), RatingDocument{
+		UserID:      e.UserID,
+		Game:        e.GameType,
+		Category:    e.Category,
+		Elo:         elo.Elo,
+		Deviation:   elo.Deviation,
+		Games:       elo.Games,
+		Provisional: elo.Provisional,
+		Timestamp:   e.Timestamp,
+	})
+}
+
+func (s *service) SyncElos(ctx context.Context, request SyncElosRequest) error {
+	if s.index == nil {
+		return nil
+	}
+
+	for _, userID := range request.UserIDs {
+		eloSnap, err := s.getCurrentEloRef(userID, request.Game, request.Category).Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		var elo EloDocument
+		err = eloSnap.DataTo(&elo)
+		if err != nil {
+			return err
+		}
+
+		err = s.indexElo(ctx, elo)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *service) GetLeaderboard(ctx context.Context, request GetLeaderboardRequest) (*Leaderboard, error) {
+	if s.index == nil {
+		return nil, errors.New("leaderboard not configured")
+	}
+
+	limit := request.Limit
+	if limit <= 0 {
+		limit = DEFAULT_LEADERBOARD_LIMIT
+	} else if limit > MAX_LEADERBOARD_LIMIT {
+		limit = MAX_LEADERBOARD_LIMIT
+	}
+
+	var offset int
+	var after string
+	if request.Cursor != "" {
+		cursor, err := index.DecodeCursor(request.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		if cursor != nil {
+			offset, after = cursor.Offset, cursor.After
+		}
+	} else if request.UserID != "" {
+		var err error
+		offset, after, err = s.aroundUser(ctx, request, limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	searchAfter := ""
+	if after != "" {
+		searchAfter = fmt.Sprintf(`,
+	"search_after": %s`, after)
+	}
+
+	// the extra player tells if there is a next page
+	resp, err := s.index.Search(ctx, fmt.Sprintf(leaderboardRequest,
+		fmt.Sprintf(leaderboardQuery, request.Game, request.Category, ""),
+		"desc", "asc",
+		limit+1,
+		searchAfter,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*LeaderboardEntry, 0)
+	var next string
+	for i, hit := range resp.Hits.Hits {
+		if i == limit {
+			last, err := json.Marshal(resp.Hits.Hits[limit-1].Sort)
+			if err != nil {
+				return nil, err
+			}
+
+			cursor := index.Cursor{
+				Offset: offset + limit,
+				After:  string(last),
+			}
+			next = cursor.Encode()
+			break
+		}
+
+		var rating RatingDocument
+		err = json.Unmarshal(hit.Source, &rating)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, &LeaderboardEntry{
+			Rank: offset + i + 1,
+			Elo: &Elo{
+				UserID:      rating.UserID,
+				Game:        rating.Game,
+				Category:    rating.Category,
+				Elo:         rating.Elo,
+				Games:       rating.Games,
+				Deviation:   rating.Deviation,
+				Provisional: rating.Provisional,
+			},
+		})
+	}
+
+	return &Leaderboard{
+		Entries: entries,
+		Next:    next,
+	}, nil
+}
+
+// aroundUser returns where the page with the user in the middle starts,
+// as the number of players before it and the search_after of its first player
+func (s *service) aroundUser(ctx context.Context, request GetLeaderboardRequest, limit int) (int, string, error) {
+	eloSnap, err := s.getCurrentEloRef(request.UserID, request.Game, request.Category).Get(ctx)
+	if err != nil {
+		return 0, "", err
+	}
+
+	var elo EloDocument
+	err = eloSnap.DataTo(&elo)
+	if err != nil {
+		return 0, "", err
+	}
+
+	resp, err := s.index.Search(ctx, fmt.Sprintf(leaderboardCountRequest,
+		fmt.Sprintf(leaderboardQuery, request.Game, request.Category,
+			fmt.Sprintf(aboveFilter, elo.Elo, elo.Elo, request.UserID),
+		),
+	))
+	if err != nil {
+		return 0, "", err
+	}
+
+	above := resp.Hits.Total.Value
+	before := limit / 2
+	if above <= before {
+		return 0, "", nil
+	}
+
+	// the players right above the user, nearest first, where the last
+	// one is just before the page
+	resp, err = s.index.Search(ctx, fmt.Sprintf(leaderboardRequest,
+		fmt.Sprintf(leaderboardQuery, request.Game, request.Category, ""),
+		"asc", "desc",
+		before+1,
+		fmt.Sprintf(`,
+	"search_after": [%d, %q]`, elo.Elo, request.UserID),
+	))
+	if err != nil {
+		return 0, "", err
+	}
+	if len(resp.Hits.Hits) <= before {
+		return 0, "", nil
+	}
+
+	last, err := json.Marshal(resp.Hits.Hits[before].Sort)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return above - before, string(last), nil
+}
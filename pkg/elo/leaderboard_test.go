@@ -0,0 +1,34 @@
+package elo
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeaderboardRequests(t *testing.T) {
+	query := fmt.Sprintf(leaderboardQuery, JANGGI, BLITZ, "")
+	above := fmt.Sprintf(leaderboardQuery, JANGGI, BLITZ, fmt.Sprintf(aboveFilter, 1500, 1500, "iusr1"))
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "mapping", body: RATINGS_MAPPING},
+		{name: "top", body: fmt.Sprintf(leaderboardRequest, query, "desc", "asc", 21, "")},
+		{
+			name: "next page",
+			body: fmt.Sprintf(leaderboardRequest, query, "desc", "asc", 21, `,
+	"search_after": [1500, "iusr1"]`),
+		},
+		{name: "count above", body: fmt.Sprintf(leaderboardCountRequest, above)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, json.Valid([]byte(tt.body)), tt.body)
+		})
+	}
+}
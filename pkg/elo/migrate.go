@@ -0,0 +1,186 @@
+package elo
+
+import (
+	"context"
+	"sort"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/garlicgarrison/chessvars-backend/pkg/users"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MAX_BATCH_WRITES is the most writes firestore takes in one batch
+const MAX_BATCH_WRITES = 500
+
+// LEGACY_CATEGORY is the category the legacy ratings are carried over to,
+// while the others start fresh. The legacy elos do not keep the time limits
+// of their games, most of which were played with the RAPID presets.
+const LEGACY_CATEGORY = RAPID
+
+// migrateElos returns the rating and history of a category carried over
+// from the legacy elos of a game, where the history is sorted by time and
+// each entry gets the delta from the one before it
+func (s *service) migrateElos(userID format.UserID, game GameType, category Category, current *EloDocument, legacy []EloDocument) (EloDocument, []EloDocument) {
+	sort.SliceStable(legacy, func(i, j int) bool {
+		return legacy[i].Timestamp.Before(legacy[j].Timestamp)
+	})
+
+	elo := s.newEloDocument(userID, game, category)
+	previous := elo.Elo
+
+	points := make([]EloDocument, 0, len(legacy))
+	for _, point := range legacy {
+		points = append(points, EloDocument{
+			UserID:    userID,
+			GameType:  game,
+			Category:  category,
+			Elo:       point.Elo,
+			Delta:     point.Elo - previous,
+			Timestamp: point.Timestamp,
+		})
+		previous = point.Elo
+	}
+
+	elo.Games = len(points)
+	switch {
+	case current != nil:
+		elo.Elo = current.Elo
+		elo.Timestamp = current.Timestamp
+	case len(points) > 0:
+		elo.Elo = points[len(points)-1].Elo
+		elo.Timestamp = points[len(points)-1].Timestamp
+	}
+
+	return elo, points
+}
+
+// getLegacyElos returns the legacy current elo of the user in the game,
+// nil if there is none, and its history
+func (s *service) getLegacyElos(ctx context.Context, userID format.UserID, game GameType) (*EloDocument, []EloDocument, error) {
+	eloSnaps, err := s.getLegacyElosRef(userID, game).
+		Documents(ctx).
+		GetAll()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var current *EloDocument
+	history := make([]EloDocument, 0)
+	for _, eloSnap := range eloSnaps {
+		var elo EloDocument
+		err = eloSnap.DataTo(&elo)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if eloSnap.Ref.ID == FS_LEGACY_CURRENT_ELO_DOC {
+			current = &elo
+			continue
+		}
+		history = append(history, elo)
+	}
+
+	return current, history, nil
+}
+
+func (s *service) MigrateElos(ctx context.Context, request MigrateElosRequest) (*MigrateElosResponse, error) {
+	reply := &MigrateElosResponse{}
+
+	iter := s.fs.Collection(users.FS_USERS_COLL).Documents(ctx)
+	defer iter.Stop()
+	for {
+		userSnap, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		userID := format.UserID(userSnap.Ref.ID)
+
+		migrated := false
+		for _, game := range GAME_TYPES {
+			current, legacy, err := s.getLegacyElos(ctx, userID, game)
+			if err != nil {
+				return nil, err
+			}
+			if current == nil && len(legacy) == 0 {
+				continue
+			}
+
+			created, points, err := s.migrateCategory(ctx, userID, game, LEGACY_CATEGORY, current, legacy)
+			if err != nil {
+				return nil, err
+			}
+
+			reply.Points += points
+			if created {
+				reply.Ratings++
+			}
+			migrated = true
+		}
+
+		if migrated {
+			reply.Users++
+		}
+	}
+
+	return reply, nil
+}
+
+// migrateCategory writes the legacy history of the game to the category,
+// and the legacy rating unless the user already has one in the category,
+// returning whether it did and how many history entries it wrote
+func (s *service) migrateCategory(ctx context.Context, userID format.UserID, game GameType, category Category, current *EloDocument, legacy []EloDocument) (bool, int, error) {
+	elo, points := s.migrateElos(userID, game, category, current, legacy)
+
+	/*
+		The ids of the entries are worked out from their time alone,
+		so running the backfill again writes over the same entries.
+	*/
+	historyRef := s.getEloHistoryRef(userID, game, category)
+	batch := s.fs.Batch()
+	writes := 0
+	for _, point := range points {
+		batch.Set(historyRef.Doc(historyID(point.Timestamp, "")), point)
+		writes++
+
+		if writes == MAX_BATCH_WRITES {
+			_, err := batch.Commit(ctx)
+			if err != nil {
+				return false, 0, err
+			}
+			batch = s.fs.Batch()
+			writes = 0
+		}
+	}
+
+	if writes > 0 {
+		_, err := batch.Commit(ctx)
+		if err != nil {
+			return false, 0, err
+		}
+	}
+
+	// a rating played since the split is newer than the legacy one
+	_, err := s.getCurrentEloRef(userID, game, category).Create(ctx, elo)
+	if status.Code(err) == codes.AlreadyExists {
+		return false, len(points), nil
+	}
+	if err != nil {
+		return false, 0, err
+	}
+
+	err = s.SyncElos(ctx, SyncElosRequest{
+		UserIDs:  []format.UserID{userID},
+		Game:     game,
+		Category: category,
+	})
+	if err != nil {
+		return false, 0, err
+	}
+
+	return true, len(points), nil
+}
@@ -1,6 +1,7 @@
 package elo
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/garlicgarrison/chessvars-backend/pkg/format"
@@ -17,6 +18,50 @@ func (g GameType) String() string {
 	return string(g)
 }
 
+// GAME_TYPES is every game type that is rated
+var GAME_TYPES = []GameType{JANGGI, SHOGI}
+
+// Category is the speed of a game, each of which is rated on its own
+type Category string
+
+const (
+	BULLET    Category = "bullet"
+	BLITZ     Category = "blitz"
+	RAPID     Category = "rapid"
+	CLASSICAL Category = "classical"
+)
+
+func (c Category) String() string {
+	return string(c)
+}
+
+// CATEGORIES is every category a game type is rated in
+var CATEGORIES = []Category{BULLET, BLITZ, RAPID, CLASSICAL}
+
+// upper bounds of the estimated duration of a game for each category
+const (
+	BULLET_DURATION = 3 * time.Minute
+	BLITZ_DURATION  = 8 * time.Minute
+	RAPID_DURATION  = 25 * time.Minute
+)
+
+// CategoryOf returns the category of a game expected to last estimate
+// on each clock, where games without a clock are CLASSICAL
+func CategoryOf(estimate time.Duration) Category {
+	switch {
+	case estimate <= 0:
+		return CLASSICAL
+	case estimate < BULLET_DURATION:
+		return BULLET
+	case estimate < BLITZ_DURATION:
+		return BLITZ
+	case estimate < RAPID_DURATION:
+		return RAPID
+	default:
+		return CLASSICAL
+	}
+}
+
 type GameStatus string
 
 const (
@@ -26,14 +71,82 @@ const (
 	DRAW   GameStatus = "draw"
 )
 
+// opposite returns the status of the other player of the game
+func opposite(status GameStatus) GameStatus {
+	switch status {
+	case WIN:
+		return LOSS
+	case LOSS:
+		return WIN
+	default:
+		return status
+	}
+}
+
 const (
-	DEFAULT_ELO          int    = 1200
-	CURRENT_ELO_DOCUMENT string = "current"
+	DEFAULT_ELO int = 1200
 )
 
 type EloDocument struct {
-	UserID    format.UserID `firestore:"user_id"`
-	GameType  GameType      `firestore:"game_type"`
-	Elo       int           `firestore:"elo"`
+	UserID   format.UserID `firestore:"user_id"`
+	GameType GameType      `firestore:"game_type"`
+	Category Category      `firestore:"category"`
+	Elo      int           `firestore:"elo"`
+	// Deviation and Volatility are left empty by plain elo
+	Deviation  float64 `firestore:"deviation"`
+	Volatility float64 `firestore:"volatility"`
+	Games      int     `firestore:"games"`
+	// Delta and GameID are the change of the last rated game
+	Delta     int           `firestore:"delta"`
+	GameID    format.GameID `firestore:"game_id"`
 	Timestamp time.Time     `firestore:"timestamp"`
 }
+
+// historyBound is the smallest id of the history entries
+// rated at or after timestamp
+func historyBound(timestamp time.Time) string {
+	return fmt.Sprintf("%020d", timestamp.UnixNano())
+}
+
+// historyID is the id of the history entry of a game, which sorts
+// in the order the games were rated
+func historyID(timestamp time.Time, gameID format.GameID) string {
+	return historyBound(timestamp) + "_" + gameID.String()
+}
+
+// rating returns the elo as kept by an Algorithm
+func (e *EloDocument) rating() Rating {
+	return Rating{
+		Elo:        e.Elo,
+		Deviation:  e.Deviation,
+		Volatility: e.Volatility,
+		Games:      e.Games,
+		LastPlayed: e.Timestamp,
+	}
+}
+
+// setRating stores the rating worked out by an Algorithm
+func (e *EloDocument) setRating(rating Rating) {
+	e.Elo = rating.Elo
+	e.Deviation = rating.Deviation
+	e.Volatility = rating.Volatility
+	e.Games = rating.Games
+	e.Timestamp = rating.LastPlayed
+}
+
+// RatingDocument is the current elo of a user as kept in the ratings index
+type RatingDocument struct {
+	UserID      format.UserID `json:"user_id"`
+	Game        GameType      `json:"game"`
+	Category    Category      `json:"category"`
+	Elo         int           `json:"elo"`
+	Deviation   float64       `json:"deviation"`
+	Games       int           `json:"games"`
+	Provisional bool          `json:"provisional"`
+	Timestamp   time.Time     `json:"timestamp"`
+}
+
+// ratingID is the id of the rating in the ratings index
+func ratingID(userID format.UserID, game GameType, category Category) string {
+	return userID.String() + "_" + game.String() + "_" + category.String()
+}
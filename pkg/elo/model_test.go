@@ -0,0 +1,55 @@
+package elo
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistoryID(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	ids := []string{
+		historyID(start, "igamb"),
+		historyID(start.Add(time.Nanosecond), "igama"),
+		historyID(start.Add(time.Second), "igama"),
+		historyID(start.Add(100*24*time.Hour), "igama"),
+	}
+	assert.True(t, sort.StringsAreSorted(ids))
+
+	// the bounds of a range include its start but not its end
+	assert.Less(t, historyBound(start), ids[0])
+	assert.Less(t, ids[0], historyBound(start.Add(time.Nanosecond)))
+}
+
+func TestMigrateElos(t *testing.T) {
+	s := &service{algorithm: eloAlgorithm{}}
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	legacy := []EloDocument{
+		{Elo: 1190, Timestamp: start.Add(2 * time.Hour)},
+		{Elo: 1215, Timestamp: start},
+		{Elo: 1230, Timestamp: start.Add(time.Hour)},
+	}
+	current := &EloDocument{Elo: 1190, Timestamp: start.Add(2 * time.Hour)}
+
+	elo, points := s.migrateElos("user", JANGGI, BLITZ, current, legacy)
+	assert.Equal(t, 1190, elo.Elo)
+	assert.Equal(t, 3, elo.Games)
+	assert.Equal(t, BLITZ, elo.Category)
+	assert.True(t, elo.Timestamp.Equal(start.Add(2*time.Hour)))
+
+	deltas := make([]int, 0)
+	for _, point := range points {
+		assert.Equal(t, BLITZ, point.Category)
+		deltas = append(deltas, point.Delta)
+	}
+	assert.Equal(t, []int{15, 15, -40}, deltas)
+
+	// without a current elo the last entry of the history is the rating
+	elo, _ = s.migrateElos("user", SHOGI, RAPID, nil, legacy[:2])
+	assert.Equal(t, 1230, elo.Elo)
+	assert.Equal(t, 2, elo.Games)
+}
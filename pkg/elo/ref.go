@@ -1,25 +1,28 @@
 package elo
 
 import (
-	"time"
-
 	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
 	"github.com/garlicgarrison/chessvars-backend/pkg/format"
 	"github.com/garlicgarrison/chessvars-backend/pkg/users"
 )
 
 const (
-	FS_ELO_COLL        = "elo"
-	FS_GAME_ELOS_COLL  = "elos"
-	FS_CURRENT_ELO_DOC = "current"
+	FS_ELO_COLL         = "elo"
+	FS_ELO_HISTORY_COLL = "history"
+
+	// ratings were kept under elo/{game}/elos before they were split by
+	// category, with the current one in FS_LEGACY_CURRENT_ELO_DOC and
+	// one more after each rated game, see MigrateElos
+	FS_LEGACY_ELOS_COLL       = "elos"
+	FS_LEGACY_CURRENT_ELO_DOC = "current"
 )
 
 // user collection ->
 // user doc ->
-// elo collection (janggi/shogi or other variants) ->
-// elo document ->
-// elos collection ->
-// elo document
+// elo collection ->
+// current elo document (one per variant and category) ->
+// history collection ->
+// elo document (one per rated game, see historyID)
 
 func (s *service) getElosRef(userID format.UserID) *firestore.CollectionRef {
 	return s.fs.Collection(users.FS_USERS_COLL).
@@ -27,16 +30,23 @@ func (s *service) getElosRef(userID format.UserID) *firestore.CollectionRef {
 		Collection(FS_ELO_COLL)
 }
 
-func (s *service) getCurrentEloRef(userID format.UserID, game GameType) *firestore.DocumentRef {
+// getCurrentEloRef is named after the variant and category it rates,
+// such as janggi_blitz
+func (s *service) getCurrentEloRef(userID format.UserID, game GameType, category Category) *firestore.DocumentRef {
 	return s.getElosRef(userID).
-		Doc(game.String()).
-		Collection(FS_GAME_ELOS_COLL).
-		Doc(FS_CURRENT_ELO_DOC)
+		Doc(game.String() + "_" + category.String())
+}
+
+// getEloHistoryRef is the elo of the user after each rated game
+func (s *service) getEloHistoryRef(userID format.UserID, game GameType, category Category) *firestore.CollectionRef {
+	return s.getCurrentEloRef(userID, game, category).
+		Collection(FS_ELO_HISTORY_COLL)
 }
 
-func (s *service) getTimestampEloRef(userID format.UserID, game GameType, timestamp time.Time) *firestore.DocumentRef {
+// getLegacyElosRef is where the elos of the user in the game were kept
+// before ratings were split by category
+func (s *service) getLegacyElosRef(userID format.UserID, game GameType) *firestore.CollectionRef {
 	return s.getElosRef(userID).
 		Doc(game.String()).
-		Collection(FS_GAME_ELOS_COLL).
-		Doc(timestamp.String())
+		Collection(FS_LEGACY_ELOS_COLL)
 }
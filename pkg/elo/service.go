@@ -3,27 +3,29 @@ package elo
 import (
 	"context"
 	"errors"
-	"fmt"
-	"math"
-	"sync"
 	"time"
 
+	"github.com/garlicgarrison/chessvars-backend/pkg/elasticsearch/index"
 	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-const (
-	K_FACTOR       = 32
-	ELO_DIFFERENCE = 400
-)
-
 type Config struct {
 	Firestore firestore.Firestore
+
+	// Algorithm defaults to plain elo
+	Algorithm Algorithm
+	// Index mirrors the current elos for leaderboards, if there is one
+	Index index.Index
 }
 
 type service struct {
 	fs firestore.Firestore
+
+	algorithm Algorithm
+	index     index.Index
 }
 
 func NewService(cfg Config) (Service, error) {
@@ -31,31 +33,51 @@ func NewService(cfg Config) (Service, error) {
 		return nil, errors.New("firestore required")
 	}
 
+	algorithm := cfg.Algorithm
+	if algorithm == nil {
+		algorithm = eloAlgorithm{}
+	}
+
 	return &service{
-		fs: cfg.Firestore,
+		fs:        cfg.Firestore,
+		algorithm: algorithm,
+		index:     cfg.Index,
 	}, nil
 }
 
 func (s *service) populateElo(e EloDocument) *Elo {
+	rating := s.algorithm.Current(e.rating(), time.Now())
+
 	return &Elo{
-		UserID: e.UserID,
-		Game:   e.GameType,
-		Elo:    e.Elo,
+		UserID:      e.UserID,
+		Game:        e.GameType,
+		Category:    e.Category,
+		Elo:         e.Elo,
+		Games:       e.Games,
+		Deviation:   rating.Deviation,
+		Provisional: s.algorithm.Provisional(rating),
+	}
+}
+
+// newEloDocument returns the elo of a user that has never played the game
+func (s *service) newEloDocument(userID format.UserID, game GameType, category Category) EloDocument {
+	elo := EloDocument{
+		UserID:   userID,
+		GameType: game,
+		Category: category,
 	}
+	elo.setRating(s.algorithm.Initial())
+	return elo
 }
 
 func (s *service) CreateElo(ctx context.Context, request CreateEloRequest) (*CreateEloResponse, error) {
 	var elo EloDocument
 	err := s.fs.RunTransaction(ctx, func(_ context.Context, t *firestore.Transaction) error {
-		eloSnap, err := t.Get(s.getCurrentEloRef(request.UserID, request.Game))
+		eloSnap, err := t.Get(s.getCurrentEloRef(request.UserID, request.Game, request.Category))
 		if err != nil {
 			if status.Code(err) == codes.NotFound {
-				elo = EloDocument{
-					UserID:   request.UserID,
-					GameType: request.Game,
-					Elo:      DEFAULT_ELO,
-				}
-				return t.Create(s.getCurrentEloRef(request.UserID, request.Game), elo)
+				elo = s.newEloDocument(request.UserID, request.Game, request.Category)
+				return t.Create(s.getCurrentEloRef(request.UserID, request.Game, request.Category), elo)
 			}
 
 			return err
@@ -71,7 +93,7 @@ func (s *service) CreateElo(ctx context.Context, request CreateEloRequest) (*Cre
 }
 
 func (s *service) GetElo(ctx context.Context, request GetEloRequest) (*GetEloResponse, error) {
-	eloSnap, err := s.getCurrentEloRef(request.UserID, request.Game).Get(ctx)
+	eloSnap, err := s.getCurrentEloRef(request.UserID, request.Game, request.Category).Get(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -109,98 +131,102 @@ func (s *service) GetElos(ctx context.Context, request GetElosRequest) (*Elos, e
 	}, nil
 }
 
-func (s *service) UpdateElo(ctx context.Context, request UpdateEloRequest) (*UpdateEloResponse, error) {
-	now := time.Now()
-
-	var wg sync.WaitGroup
-	wg.Add(2)
+// getEloInTransaction returns the current elo of the user,
+// which is the initial rating if it has never played the game
+func (s *service) getEloInTransaction(t *firestore.Transaction, userID format.UserID, game GameType, category Category) (*EloDocument, error) {
+	eloSnap, err := t.Get(s.getCurrentEloRef(userID, game, category))
+	if status.Code(err) == codes.NotFound {
+		elo := s.newEloDocument(userID, game, category)
+		return &elo, nil
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	type eloStruct struct {
-		elo *Elo
-		err error
+	var elo EloDocument
+	err = eloSnap.DataTo(&elo)
+	if err != nil {
+		return nil, err
 	}
-	eloChan := make(chan eloStruct, 2)
-	// get own elo
-	go func() {
-		defer wg.Done()
-
-		elo, err := s.GetElo(ctx, GetEloRequest{
-			UserID: request.UserID,
-			Game:   request.Game,
-		})
-		eloChan <- eloStruct{
-			elo: elo,
-			err: err,
-		}
-	}()
-
-	// get other elo
-	go func() {
-		defer wg.Done()
-
-		elo, err := s.GetElo(ctx, GetEloRequest{
-			UserID: request.OtherUserID,
-			Game:   request.Game,
-		})
-		eloChan <- eloStruct{
-			elo: elo,
-			err: err,
+
+	return &elo, nil
+}
+
+func (s *service) UpdateElos(ctx context.Context, t *firestore.Transaction, request UpdateElosRequest) (*UpdateElosResponse, error) {
+	now := time.Now()
+	userIDs := [2]format.UserID{request.UserID, request.OtherUserID}
+
+	/*
+		Every read has to happen before the first write of the transaction.
+		The history keeps the id of the game each entry was rated for, so a
+		game that was already rated is returned as it was instead of rated again.
+	*/
+	var elos [2]*EloDocument
+	var rated [2]*EloDocument
+	for i, userID := range userIDs {
+		elo, err := s.getEloInTransaction(t, userID, request.Game, request.Category)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	wg.Wait()
-
-	var err error
-	var myElo Elo
-	var otherElo Elo
-	for e := range eloChan {
-		if e.err != nil {
-			err = fmt.Errorf("%sget elo error -- %s --", err, e.err)
-			break
+		elos[i] = elo
+
+		gameEloSnaps, err := t.Documents(
+			s.getEloHistoryRef(userID, request.Game, request.Category).
+				Where("game_id", "==", request.GameID).
+				Limit(1),
+		).GetAll()
+		if err != nil {
+			return nil, err
 		}
-		if e.elo.UserID == request.UserID {
-			myElo = *e.elo
-		} else if e.elo.UserID == request.OtherUserID {
-			otherElo = *e.elo
+		if len(gameEloSnaps) > 0 {
+			var gameElo EloDocument
+			err = gameEloSnaps[0].DataTo(&gameElo)
+			if err != nil {
+				return nil, err
+			}
+			rated[i] = &gameElo
 		}
 	}
-	if err != nil {
-		return nil, err
-	}
 
-	transformR1 := math.Pow(10, float64(myElo.Elo)/float64(ELO_DIFFERENCE))
-	transformR2 := math.Pow(10, float64(otherElo.Elo)/float64(ELO_DIFFERENCE))
-	expected := transformR1 / (transformR1 + transformR2)
-
-	var s1 float64
-	switch request.Status {
-	case WIN:
-		s1 = 1
-	case LOSS:
-		s1 = 0
-	case DRAW:
-		s1 = 0.5
-	default:
-		s1 = 0
+	if rated[0] != nil && rated[1] != nil {
+		return &UpdateElosResponse{
+			Elo:        s.populateElo(*rated[0]),
+			OtherElo:   s.populateElo(*rated[1]),
+			Delta:      rated[0].Delta,
+			OtherDelta: rated[1].Delta,
+		}, nil
 	}
 
-	newElo := int(math.Round(float64(myElo.Elo) + float64(K_FACTOR)*(s1-expected)))
-	batch := s.fs.Batch()
-	batch.Set(s.getCurrentEloRef(request.UserID, request.Game), EloDocument{
-		UserID:    request.UserID,
-		GameType:  request.Game,
-		Elo:       newElo,
-		Timestamp: now,
-	}).Set(s.getTimestampEloRef(request.UserID, request.Game, now), EloDocument{
-		UserID:    request.UserID,
-		GameType:  request.Game,
-		Elo:       newElo,
-		Timestamp: now,
-	})
-	_, err = batch.Commit(ctx)
-	if err != nil {
-		return nil, err
+	statuses := [2]GameStatus{request.Status, opposite(request.Status)}
+	var updated [2]EloDocument
+	for i, userID := range userIDs {
+		rating := s.algorithm.Rate(elos[i].rating(), elos[1-i].rating(), statuses[i], now)
+		updated[i] = EloDocument{
+			UserID:   userID,
+			GameType: request.Game,
+			Category: request.Category,
+			Delta:    rating.Elo - elos[i].Elo,
+			GameID:   request.GameID,
+		}
+		updated[i].setRating(rating)
+
+		err := t.Set(s.getCurrentEloRef(userID, request.Game, request.Category), updated[i])
+		if err != nil {
+			return nil, err
+		}
+		err = t.Set(
+			s.getEloHistoryRef(userID, request.Game, request.Category).Doc(historyID(now, request.GameID)),
+			updated[i],
+		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	myElo.Elo = newElo
-	return &myElo, nil
+	return &UpdateElosResponse{
+		Elo:        s.populateElo(updated[0]),
+		OtherElo:   s.populateElo(updated[1]),
+		Delta:      updated[0].Delta,
+		OtherDelta: updated[1].Delta,
+	}, nil
 }
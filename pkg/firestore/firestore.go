@@ -17,9 +17,16 @@ import (
 // writing to it. If the check fails, the write does not occur.
 var Exists = firestore.Exists
 
+// Asc sorts results from smallest to largest.
+const Asc = firestore.Asc
+
 // Desc sorts results from largest to smallest.
 const Desc = firestore.Desc
 
+// DocumentID is the special field name representing the ID of a document
+// in queries.
+const DocumentID = firestore.DocumentID
+
 type Query = firestore.Query
 
 // Transaction represents a Firestore transaction.
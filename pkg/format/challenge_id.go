@@ -0,0 +1,46 @@
+package format
+
+const (
+	CHALLENGE_ID_PREFIX = "ichl"
+)
+
+type ChallengeIDType int
+
+func (id ChallengeIDType) IDMethod() IDMethod {
+	return IDMETHOD_RANDOM
+}
+
+func (id ChallengeIDType) Prefix() string {
+	return CHALLENGE_ID_PREFIX
+}
+
+func (id ChallengeIDType) Size() uint {
+	return 32
+}
+
+type ChallengeID string
+
+func NewChallengeID() ChallengeID {
+	return ChallengeID(NewID(ChallengeIDType(0)).String())
+}
+
+func NewChallengeIDFromIdentifer(id string) ChallengeID {
+	return ChallengeID(CHALLENGE_ID_PREFIX + id)
+}
+
+func ParseChallengeID(id string) (ChallengeID, error) {
+	parsed, err := ParseID(ChallengeIDType(0), id)
+	if err != nil {
+		return "", err
+	}
+
+	return ChallengeID(parsed.String()), nil
+}
+
+func (c ChallengeID) String() string {
+	return string(c)
+}
+
+func (c ChallengeID) Identifier() string {
+	return string(c[4:])
+}
@@ -12,6 +12,41 @@ type Service interface {
 	GetGame(context.Context, GetGameRequest) (*GetGameResponse, error)
 	EditGame(context.Context, EditGameRequest) (*EditGameResponse, error)
 	JoinGame(context.Context, JoinGameRequest) (*EditGameResponse, error)
+
+	// SweepGames closes games that are past their deadline,
+	// meant to be run periodically in the background
+	SweepGames(context.Context, SweepGamesRequest) (*SweepGamesResponse, error)
+
+	// GetLobby lists the games waiting for a second player, newest first
+	GetLobby(context.Context, GetLobbyRequest) (*Games, error)
+	// GetGames lists the games of a user, newest first
+	GetGames(context.Context, GetGamesRequest) (*Games, error)
+
+	// ResignGame ends the game as a loss of the user
+	ResignGame(context.Context, GameActionRequest) (*EditGameResponse, error)
+	// OfferDraw offers the opponent a draw, or agrees to the one it offered
+	OfferDraw(context.Context, GameActionRequest) (*EditGameResponse, error)
+	RespondDraw(context.Context, RespondOfferRequest) (*EditGameResponse, error)
+	// RequestTakeback asks the opponent to let the user take back its last move,
+	// along with the reply of the opponent when it is the user's turn
+	RequestTakeback(context.Context, GameActionRequest) (*EditGameResponse, error)
+	RespondTakeback(context.Context, RespondOfferRequest) (*EditGameResponse, error)
+
+	// SetAway records the user going away from or coming back to the game
+	// it is playing, which is not allowed once the game is over or when
+	// the user already was
+	SetAway(context.Context, SetAwayRequest) (*EditGameResponse, error)
+	// ClaimVictory wins the game for the user once its opponent
+	// has been away for CLAIM_VICTORY_GRACE
+	ClaimVictory(context.Context, GameActionRequest) (*EditGameResponse, error)
+
+	// RebuildStats works the stats of every user out again from all the
+	// games played, meant to be run once in a while as a backfill
+	RebuildStats(context.Context, RebuildStatsRequest) (*RebuildStatsResponse, error)
+
+	// RefreshGames saves every game with its derived fields worked out
+	// again, meant to be run as a backfill when new ones are added
+	RefreshGames(context.Context, RefreshGamesRequest) (*RefreshGamesResponse, error)
 }
 
 type MoveResponse struct {
@@ -25,11 +60,36 @@ type Game struct {
 	PlayerOne format.UserID  `json:"player_one"`
 	PlayerTwo format.UserID  `json:"player_two"`
 	Moves     []MoveResponse `json:"moves"`
+	Position  string         `json:"position"`
 	Draw      bool           `json:"draw"`
 	Aborted   bool           `json:"aborted"`
-	TimeLimit TimeLimit      `json:"time_limit"`
-	Type      GameType       `json:"type"`
-	Timestamp time.Time      `json:"timestamp"`
+	EndReason EndReason      `json:"end_reason"`
+	DrawOffer format.UserID  `json:"draw_offer"`
+	// TakebackOffer is the player that asked to take back its last move
+	TakebackOffer format.UserID `json:"takeback_offer"`
+	TimeLimit     TimeLimit     `json:"time_limit"`
+	// TimeControl is always set, from TimeLimit for older games
+	TimeControl TimeControl `json:"time_control"`
+	Type        GameType    `json:"type"`
+	StartTime   time.Time   `json:"start_time"`
+	Timestamp   time.Time   `json:"timestamp"`
+
+	// when each player went away from the game, zero while it is there
+	PlayerOneAway time.Time `json:"player_one_away"`
+	PlayerTwoAway time.Time `json:"player_two_away"`
+
+	// remaining time of each player when the game was read
+	PlayerOneClock Clock `json:"player_one_clock"`
+	PlayerTwoClock Clock `json:"player_two_clock"`
+
+	PlayerOneEloDelta int `json:"player_one_elo_delta"`
+	PlayerTwoEloDelta int `json:"player_two_elo_delta"`
+}
+
+type Games struct {
+	Games []*Game `json:"games"`
+	// Next is the cursor of the next page, empty on the last one
+	Next string `json:"next"`
 }
 
 type GetGameRequest struct {
@@ -41,7 +101,15 @@ type GetGameResponse = Game
 type CreateGameRequest struct {
 	UserID    format.UserID `json:"user_id"`
 	TimeLimit TimeLimit     `json:"time_limit"`
-	Type      GameType      `json:"type"`
+	// TimeControl is used over the preset of TimeLimit when set
+	TimeControl *TimeControl `json:"time_control"`
+	Type        GameType     `json:"type"`
+
+	// OpponentID fills the other seat and starts the game right away,
+	// i.e. for players paired by matchmaking
+	OpponentID format.UserID `json:"opponent_id"`
+	// Side is the seat of UserID, which is random when nil
+	Side *Side `json:"side"`
 }
 
 type CreateGameResponse = Game
@@ -51,13 +119,88 @@ type EditGameRequest struct {
 	GameID format.GameID `json:"game_id"`
 	Move   *MoveNotation `json:"move"`
 
-	/* For now, we trust the client on status of the game */
+	/*
+		The result of a move is decided by the server.
+		Clients may only resign (LOSS) or offer/accept a draw (DRAW).
+	*/
 	Status GameStatus `json:"status"`
 }
 
+// GameActionRequest is an action of a player outside of its moves,
+// which can be taken on either player's turn
+type GameActionRequest struct {
+	UserID format.UserID `json:"user_id"`
+	GameID format.GameID `json:"game_id"`
+}
+
+// RespondOfferRequest answers what the opponent offered
+type RespondOfferRequest struct {
+	UserID format.UserID `json:"user_id"`
+	GameID format.GameID `json:"game_id"`
+	Accept bool          `json:"accept"`
+}
+
+type SetAwayRequest struct {
+	UserID format.UserID `json:"user_id"`
+	GameID format.GameID `json:"game_id"`
+	Away   bool          `json:"away"`
+	// Since is when the player went away, which is now when it is zero
+	Since time.Time `json:"since"`
+}
+
 type JoinGameRequest struct {
 	GameID format.GameID `json:"game_id"`
 	UserID format.UserID `json:"user_id"`
 }
 
 type EditGameResponse = Game
+
+type RebuildStatsRequest struct{}
+
+type RebuildStatsResponse struct {
+	// Games is how many games were counted towards the stats
+	Games int `json:"games"`
+	Users int `json:"users"`
+}
+
+type RefreshGamesRequest struct{}
+
+type RefreshGamesResponse struct {
+	Games int `json:"games"`
+}
+
+type SweepGamesRequest struct {
+	Limit int `json:"limit"`
+}
+
+type SweepGamesResponse struct {
+	// Games is the games that were closed, as they are now
+	Games []*Game `json:"games"`
+}
+
+type GetGamesRequest struct {
+	UserID format.UserID `json:"user_id"`
+
+	// the filters below are only applied when set
+	Type      GameType  `json:"type"`
+	TimeLimit TimeLimit `json:"time_limit"`
+	// Result is from the point of view of UserID, where
+	// INGAME lists the games that have not ended
+	Result     GameStatus    `json:"result"`
+	OpponentID format.UserID `json:"opponent_id"`
+	// From and To bound the creation time of the games to [From, To)
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+
+	Cursor string `json:"cursor"`
+	Limit  int    `json:"limit"`
+}
+
+type GetLobbyRequest struct {
+	// Type and TimeLimit only list games of the given kind when set
+	Type      GameType  `json:"type"`
+	TimeLimit TimeLimit `json:"time_limit"`
+
+	Cursor string `json:"cursor"`
+	Limit  int    `json:"limit"`
+}
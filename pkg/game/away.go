@@ -0,0 +1,84 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+)
+
+// away returns when the player went away from the game, zero if it is there
+func (g *GameDocument) away(userID format.UserID) *time.Time {
+	if userID == g.PlayerOne {
+		return &g.PlayerOneAway
+	}
+	return &g.PlayerTwoAway
+}
+
+// setAway records the player going away at now or coming back,
+// and returns false if it already was
+func (g *GameDocument) setAway(userID format.UserID, away bool, now time.Time) bool {
+	since := g.away(userID)
+	if since.IsZero() != away {
+		return false
+	}
+
+	if away {
+		*since = now
+	} else {
+		*since = time.Time{}
+	}
+	return true
+}
+
+// claimable returns an error unless the player has been away
+// for CLAIM_VICTORY_GRACE at now
+func (g *GameDocument) claimable(userID format.UserID, now time.Time) error {
+	since := *g.away(userID)
+	if since.IsZero() {
+		return NewNotAllowedError(fmt.Errorf("opponent is still in the game"))
+	}
+	if now.Sub(since) < CLAIM_VICTORY_GRACE {
+		return NewNotAllowedError(fmt.Errorf("opponent can still come back until %s", since.Add(CLAIM_VICTORY_GRACE).Format(time.RFC3339)))
+	}
+	return nil
+}
+
+func (s *service) SetAway(ctx context.Context, request SetAwayRequest) (*EditGameResponse, error) {
+	when := request.Since
+	if when.IsZero() {
+		when = time.Now()
+	}
+
+	action := GameActionRequest{UserID: request.UserID, GameID: request.GameID}
+	return s.playGameAction(ctx, action, func(_ *firestore.Transaction, game *GameDocument, _ format.UserID) error {
+		if !game.started() {
+			return NewNotAllowedError(fmt.Errorf("game has not started"))
+		}
+
+		// every connection of the player to the game reports it, so
+		// only the first one of them gets to change anything
+		if game.setAway(request.UserID, request.Away, when) {
+			return nil
+		}
+		if request.Away {
+			return NewNotAllowedError(fmt.Errorf("player is already away"))
+		}
+		return NewNotAllowedError(fmt.Errorf("player is already in the game"))
+	})
+}
+
+func (s *service) ClaimVictory(ctx context.Context, request GameActionRequest) (*EditGameResponse, error) {
+	now := time.Now()
+
+	return s.playGameAction(ctx, request, func(t *firestore.Transaction, game *GameDocument, otherUserID format.UserID) error {
+		err := game.claimable(otherUserID, now)
+		if err != nil {
+			return err
+		}
+
+		return s.finishGame(ctx, t, game, request.UserID, otherUserID, WIN, ABANDONMENT)
+	})
+}
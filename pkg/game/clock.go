@@ -0,0 +1,263 @@
+package game
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/elo"
+)
+
+// ESTIMATED_MOVES is how many moves a game is expected to last
+// when working out how long its clock will run
+const ESTIMATED_MOVES = 40
+
+// TimeControl is how much time each player gets.
+//
+// Base is the main time. Once it runs out, a player with byo-yomi gets
+// Periods periods of PeriodLength each, where a move made within a period
+// keeps it and every period that runs out is lost. Increment is added to
+// the main time after each move, and Delay is spent at the start of every
+// move before the clock starts running.
+type TimeControl struct {
+	Base         time.Duration `firestore:"base" json:"base"`
+	Increment    time.Duration `firestore:"increment" json:"increment"`
+	Delay        time.Duration `firestore:"delay" json:"delay"`
+	Periods      int           `firestore:"periods" json:"periods"`
+	PeriodLength time.Duration `firestore:"period_length" json:"period_length"`
+}
+
+// Validate checks that the time control can be played with
+func (tc TimeControl) Validate() error {
+	if tc.Base < 0 || tc.Increment < 0 || tc.Delay < 0 || tc.Periods < 0 || tc.PeriodLength < 0 {
+		return NewNotAllowedError(fmt.Errorf("time control cannot be negative"))
+	}
+	if (tc.Periods > 0) != (tc.PeriodLength > 0) {
+		return NewNotAllowedError(fmt.Errorf("byo-yomi needs both periods and a period length"))
+	}
+	if !tc.timed() {
+		return NewNotAllowedError(fmt.Errorf("time control needs main time or byo-yomi"))
+	}
+	return nil
+}
+
+// TimeControl returns the preset of the time limit,
+// which is its minutes of main time only
+func (t TimeLimit) TimeControl() TimeControl {
+	return TimeControl{Base: time.Duration(t) * time.Minute}
+}
+
+// Category returns the rating category of the time control, from how long
+// a clock is expected to run over ESTIMATED_MOVES moves
+func (tc TimeControl) Category() elo.Category {
+	if !tc.timed() {
+		return elo.CLASSICAL
+	}
+
+	estimate := tc.Base + ESTIMATED_MOVES*(tc.Increment+tc.Delay)
+	if tc.byoYomi() {
+		estimate += ESTIMATED_MOVES * tc.PeriodLength
+	}
+	return elo.CategoryOf(estimate)
+}
+
+func (tc TimeControl) timed() bool {
+	return tc.Base > 0 || tc.byoYomi()
+}
+
+func (tc TimeControl) byoYomi() bool {
+	return tc.Periods > 0 && tc.PeriodLength > 0
+}
+
+// Clock is the time a player has left.
+//
+// Time is the main time, or once it has run out, what is left of the
+// current byo-yomi period. Periods is the number of periods left.
+type Clock struct {
+	Time    time.Duration `firestore:"time" json:"time"`
+	Periods int           `firestore:"periods" json:"periods"`
+}
+
+func (tc TimeControl) newClock() Clock {
+	return Clock{Time: tc.Base, Periods: tc.Periods}
+}
+
+// spent is how much of elapsed counts against the clock
+func (tc TimeControl) spent(elapsed time.Duration) time.Duration {
+	elapsed -= tc.Delay
+	if elapsed < 0 {
+		return 0
+	}
+	return elapsed
+}
+
+// run returns the clock after it has run for elapsed,
+// and false if the flag fell
+func (tc TimeControl) run(c Clock, elapsed time.Duration) (Clock, bool) {
+	spent := tc.spent(elapsed)
+	if spent < c.Time {
+		return Clock{Time: c.Time - spent, Periods: c.Periods}, true
+	}
+
+	if !tc.byoYomi() {
+		return Clock{}, false
+	}
+
+	over := spent - c.Time
+	lost := int(over / tc.PeriodLength)
+	if lost >= c.Periods {
+		return Clock{}, false
+	}
+
+	return Clock{
+		Time:    tc.PeriodLength - over%tc.PeriodLength,
+		Periods: c.Periods - lost,
+	}, true
+}
+
+// stop returns the stored clock after a move made in elapsed
+func (tc TimeControl) stop(c Clock, elapsed time.Duration) Clock {
+	left := tc.pause(c, elapsed)
+	if tc.spent(elapsed) < c.Time {
+		left.Time += tc.Increment
+	}
+	return left
+}
+
+// pause returns the stored clock after it ran for elapsed without a move
+func (tc TimeControl) pause(c Clock, elapsed time.Duration) Clock {
+	left, ok := tc.run(c, elapsed)
+	if !ok {
+		return Clock{}
+	}
+
+	// in byo-yomi the next period starts over
+	if tc.spent(elapsed) >= c.Time {
+		return Clock{Periods: left.Periods}
+	}
+
+	return left
+}
+
+// left is how long the clock can run before the flag falls
+func (tc TimeControl) left(c Clock) time.Duration {
+	left := tc.Delay + c.Time
+	if tc.byoYomi() {
+		left += time.Duration(c.Periods) * tc.PeriodLength
+	}
+	return left
+}
+
+// timeControl returns the time control of the game, falling back
+// to the preset of the time limit for games created before it existed
+func (g *GameDocument) timeControl() TimeControl {
+	if g.TimeControl.timed() {
+		return g.TimeControl
+	}
+	return g.TimeLimit.TimeControl()
+}
+
+func (g *GameDocument) timed() bool {
+	return g.timeControl().timed()
+}
+
+// Timed returns true if the game is played on a clock
+func (g *Game) Timed() bool {
+	return g.TimeControl.timed()
+}
+
+func (g *GameDocument) started() bool {
+	return !g.StartTime.IsZero()
+}
+
+func (g *GameDocument) ended() bool {
+	return g.Aborted || g.Draw || g.WinnerID != ""
+}
+
+// resetClocks gives both players the full time of the game
+func (g *GameDocument) resetClocks() {
+	g.PlayerOneClock = g.timeControl().newClock()
+	g.PlayerTwoClock = g.timeControl().newClock()
+}
+
+// clock returns the stored clock of the side to move, which is how much
+// it had left when its opponent last moved
+func (g *GameDocument) clock() *Clock {
+	if len(g.Moves)%2 == 0 {
+		return &g.PlayerOneClock
+	}
+	return &g.PlayerTwoClock
+}
+
+// clockStart is when the clock of the side to move started running,
+// which is restarted when moves are taken back
+func (g *GameDocument) clockStart() time.Time {
+	start := g.StartTime
+	if len(g.Moves) > 0 {
+		start = g.Moves[len(g.Moves)-1].Timestamp
+	}
+	if g.TakebackTime.After(start) {
+		return g.TakebackTime
+	}
+	return start
+}
+
+// Clocks returns the remaining time of both players at now,
+// counting down the side to move while the game is running
+func (g *GameDocument) Clocks(now time.Time) (Clock, Clock) {
+	tc := g.timeControl()
+
+	// the clock that is not running shows a fresh byo-yomi period
+	playerOne, _ := tc.run(g.PlayerOneClock, 0)
+	playerTwo, _ := tc.run(g.PlayerTwoClock, 0)
+	if !g.timed() || !g.started() || g.ended() {
+		return playerOne, playerTwo
+	}
+
+	remaining, _ := tc.run(*g.clock(), now.Sub(g.clockStart()))
+	if len(g.Moves)%2 == 0 {
+		playerOne = remaining
+	} else {
+		playerTwo = remaining
+	}
+	return playerOne, playerTwo
+}
+
+// flagged returns true if the side to move has run out of time at now
+func (g *GameDocument) flagged(now time.Time) bool {
+	if !g.timed() || !g.started() || g.ended() {
+		return false
+	}
+
+	_, ok := g.timeControl().run(*g.clock(), now.Sub(g.clockStart()))
+	return !ok
+}
+
+// tick stops the clock of the side to move at now,
+// before its move is appended
+func (g *GameDocument) tick(now time.Time) {
+	if !g.timed() || !g.started() {
+		return
+	}
+
+	clock := g.clock()
+	*clock = g.timeControl().stop(*clock, now.Sub(g.clockStart()))
+}
+
+// updateDeadline works out when the game has to be swept next
+func (g *GameDocument) updateDeadline() {
+	var deadline time.Time
+	switch {
+	case g.ended():
+		g.Deadline = nil
+		return
+	case !g.started():
+		deadline = g.Timestamp.Add(JOIN_TIMEOUT)
+	case g.timed():
+		deadline = g.clockStart().Add(g.timeControl().left(*g.clock()))
+	default:
+		g.Deadline = nil
+		return
+	}
+
+	g.Deadline = &deadline
+}
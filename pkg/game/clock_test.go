@@ -0,0 +1,314 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/elo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClocks(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	newGame := func() *GameDocument {
+		game := &GameDocument{
+			PlayerOne: "player_one",
+			PlayerTwo: "player_two",
+			TimeLimit: BULLET,
+			StartTime: start,
+		}
+		game.resetClocks()
+		return game
+	}
+
+	t.Run("counts down the side to move", func(t *testing.T) {
+		game := newGame()
+
+		playerOne, playerTwo := game.Clocks(start.Add(10 * time.Second))
+		assert.Equal(t, Clock{Time: 50 * time.Second}, playerOne)
+		assert.Equal(t, Clock{Time: time.Minute}, playerTwo)
+		assert.False(t, game.flagged(start.Add(10*time.Second)))
+	})
+
+	t.Run("tick stops the clock of the mover", func(t *testing.T) {
+		game := newGame()
+
+		now := start.Add(15 * time.Second)
+		game.tick(now)
+		game.Moves = append(game.Moves, Move{Move: "7g7f", Timestamp: now})
+
+		playerOne, playerTwo := game.Clocks(now.Add(20 * time.Second))
+		assert.Equal(t, Clock{Time: 45 * time.Second}, playerOne)
+		assert.Equal(t, Clock{Time: 40 * time.Second}, playerTwo)
+	})
+
+	t.Run("flag falls at zero", func(t *testing.T) {
+		game := newGame()
+
+		assert.True(t, game.flagged(start.Add(time.Minute)))
+		playerOne, _ := game.Clocks(start.Add(2 * time.Minute))
+		assert.Equal(t, Clock{}, playerOne)
+	})
+
+	t.Run("untimed and unstarted games do not run", func(t *testing.T) {
+		game := newGame()
+		game.TimeLimit = 0
+		assert.False(t, game.flagged(start.Add(time.Hour)))
+
+		game = newGame()
+		game.StartTime = time.Time{}
+		assert.False(t, game.flagged(start.Add(time.Hour)))
+	})
+
+	t.Run("finished games do not run", func(t *testing.T) {
+		game := newGame()
+		game.WinnerID = "player_two"
+
+		playerOne, _ := game.Clocks(start.Add(time.Hour))
+		assert.Equal(t, Clock{Time: time.Minute}, playerOne)
+		assert.False(t, game.flagged(start.Add(time.Hour)))
+	})
+}
+
+func TestTimeControl(t *testing.T) {
+	tests := []struct {
+		name        string
+		timeControl TimeControl
+		clock       Clock
+		elapsed     time.Duration
+		// run is the live clock after elapsed, stop is the stored clock
+		// after a move made in elapsed
+		run     Clock
+		stop    Clock
+		flagged bool
+	}{
+		{
+			name:        "increment",
+			timeControl: TimeControl{Base: time.Minute, Increment: 2 * time.Second},
+			clock:       Clock{Time: time.Minute},
+			elapsed:     10 * time.Second,
+			run:         Clock{Time: 50 * time.Second},
+			stop:        Clock{Time: 52 * time.Second},
+		},
+		{
+			name:        "delay is not spent",
+			timeControl: TimeControl{Base: time.Minute, Delay: 5 * time.Second},
+			clock:       Clock{Time: time.Minute},
+			elapsed:     3 * time.Second,
+			run:         Clock{Time: time.Minute},
+			stop:        Clock{Time: time.Minute},
+		},
+		{
+			name:        "delay is taken off",
+			timeControl: TimeControl{Base: time.Minute, Delay: 5 * time.Second},
+			clock:       Clock{Time: time.Minute},
+			elapsed:     15 * time.Second,
+			run:         Clock{Time: 50 * time.Second},
+			stop:        Clock{Time: 50 * time.Second},
+		},
+		{
+			name:        "flag falls without byo-yomi",
+			timeControl: TimeControl{Base: time.Minute, Increment: 2 * time.Second},
+			clock:       Clock{Time: time.Minute},
+			elapsed:     time.Minute,
+			flagged:     true,
+		},
+		{
+			name:        "into byo-yomi",
+			timeControl: TimeControl{Base: time.Minute, Periods: 3, PeriodLength: 30 * time.Second},
+			clock:       Clock{Time: time.Minute, Periods: 3},
+			elapsed:     70 * time.Second,
+			run:         Clock{Time: 20 * time.Second, Periods: 3},
+			stop:        Clock{Periods: 3},
+		},
+		{
+			name:        "byo-yomi period lost",
+			timeControl: TimeControl{Base: time.Minute, Periods: 3, PeriodLength: 30 * time.Second},
+			clock:       Clock{Periods: 3},
+			elapsed:     40 * time.Second,
+			run:         Clock{Time: 20 * time.Second, Periods: 2},
+			stop:        Clock{Periods: 2},
+		},
+		{
+			name:        "byo-yomi periods run out",
+			timeControl: TimeControl{Base: time.Minute, Periods: 3, PeriodLength: 30 * time.Second},
+			clock:       Clock{Periods: 1},
+			elapsed:     30 * time.Second,
+			flagged:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			run, ok := tt.timeControl.run(tt.clock, tt.elapsed)
+			assert.Equal(t, tt.flagged, !ok)
+			assert.Equal(t, tt.run, run)
+			assert.Equal(t, tt.stop, tt.timeControl.stop(tt.clock, tt.elapsed))
+		})
+	}
+}
+
+func TestTimeLimitPreset(t *testing.T) {
+	game := &GameDocument{TimeLimit: BLITZ}
+	assert.Equal(t, TimeControl{Base: 3 * time.Minute}, game.timeControl())
+
+	game.TimeControl = TimeControl{Base: time.Minute, Increment: time.Second}
+	assert.Equal(t, game.TimeControl, game.timeControl())
+}
+
+func TestCategory(t *testing.T) {
+	tests := []struct {
+		name        string
+		timeControl TimeControl
+		want        elo.Category
+	}{
+		{name: "untimed", timeControl: TimeControl{}, want: elo.CLASSICAL},
+		{name: "bullet preset", timeControl: BULLET.TimeControl(), want: elo.BULLET},
+		{name: "blitz preset", timeControl: BLITZ.TimeControl(), want: elo.BLITZ},
+		{name: "rapid preset", timeControl: RAPID.TimeControl(), want: elo.RAPID},
+		{name: "longest preset", timeControl: RAPID4.TimeControl(), want: elo.CLASSICAL},
+		{
+			name:        "increment",
+			timeControl: TimeControl{Base: 2 * time.Minute, Increment: 2 * time.Second},
+			want:        elo.BLITZ,
+		},
+		{
+			name:        "byo-yomi",
+			timeControl: TimeControl{Periods: 3, PeriodLength: 10 * time.Second},
+			want:        elo.BLITZ,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.timeControl.Category())
+		})
+	}
+}
+
+func TestUpdateDeadline(t *testing.T) {
+	created := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	start := created.Add(time.Minute)
+
+	tests := []struct {
+		name     string
+		game     GameDocument
+		deadline *time.Time
+	}{
+		{
+			name:     "waiting for an opponent",
+			game:     GameDocument{TimeLimit: BULLET, Timestamp: created},
+			deadline: timePtr(created.Add(JOIN_TIMEOUT)),
+		},
+		{
+			name:     "flag of player one",
+			game:     GameDocument{TimeLimit: BULLET, Timestamp: created, StartTime: start, PlayerOneClock: Clock{Time: time.Minute}},
+			deadline: timePtr(start.Add(time.Minute)),
+		},
+		{
+			name: "flag of player two",
+			game: GameDocument{
+				TimeLimit:      BULLET,
+				Timestamp:      created,
+				StartTime:      start,
+				Moves:          []Move{{Move: "7g7f", Timestamp: start.Add(5 * time.Second)}},
+				PlayerOneClock: Clock{Time: 55 * time.Second},
+				PlayerTwoClock: Clock{Time: 30 * time.Second},
+			},
+			deadline: timePtr(start.Add(35 * time.Second)),
+		},
+		{
+			name: "byo-yomi",
+			game: GameDocument{
+				TimeControl:    TimeControl{Base: time.Minute, Periods: 2, PeriodLength: 10 * time.Second},
+				Timestamp:      created,
+				StartTime:      start,
+				PlayerOneClock: Clock{Time: time.Minute, Periods: 2},
+			},
+			deadline: timePtr(start.Add(80 * time.Second)),
+		},
+		{
+			name:     "untimed",
+			game:     GameDocument{Timestamp: created, StartTime: start},
+			deadline: nil,
+		},
+		{
+			name:     "finished",
+			game:     GameDocument{TimeLimit: BULLET, Timestamp: created, StartTime: start, Draw: true},
+			deadline: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.game.updateDeadline()
+			assert.Equal(t, tt.deadline, tt.game.Deadline)
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestTakeback(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	newGame := func() *GameDocument {
+		game := &GameDocument{
+			Type:      SHOGI,
+			PlayerOne: "player_one",
+			PlayerTwo: "player_two",
+			TimeLimit: BULLET,
+			StartTime: start,
+		}
+		game.resetClocks()
+
+		for i, m := range []MoveNotation{"7g7f", "3c3d"} {
+			now := start.Add(time.Duration(10+5*i) * time.Second)
+			game.tick(now)
+			game.Moves = append(game.Moves, Move{Move: m, Timestamp: now})
+		}
+		return game
+	}
+	now := start.Add(20 * time.Second)
+
+	t.Run("takes back the last move of the opponent of the side to move", func(t *testing.T) {
+		game := newGame()
+		assert.Equal(t, 1, game.takebackMoves("player_two"))
+
+		err := game.takeback("player_two", now)
+		assert.NoError(t, err)
+		assert.Len(t, game.Moves, 1)
+
+		variant, err := GetVariant(SHOGI)
+		assert.NoError(t, err)
+		pos, err := replay(variant, game.Moves)
+		assert.NoError(t, err)
+		assert.Equal(t, pos.String(), game.Position)
+
+		playerOne, playerTwo := game.Clocks(now.Add(5 * time.Second))
+		assert.Equal(t, Clock{Time: 45 * time.Second}, playerOne)
+		assert.Equal(t, Clock{Time: 50 * time.Second}, playerTwo)
+	})
+
+	t.Run("takes back the reply as well on the turn of the user", func(t *testing.T) {
+		game := newGame()
+		assert.Equal(t, 2, game.takebackMoves("player_one"))
+
+		err := game.takeback("player_one", now)
+		assert.NoError(t, err)
+		assert.Empty(t, game.Moves)
+
+		playerOne, playerTwo := game.Clocks(now.Add(5 * time.Second))
+		assert.Equal(t, Clock{Time: 40 * time.Second}, playerOne)
+		assert.Equal(t, Clock{Time: 55 * time.Second}, playerTwo)
+	})
+
+	t.Run("needs a move to take back", func(t *testing.T) {
+		game := newGame()
+		game.Moves = game.Moves[:1]
+
+		err := game.takeback("player_two", now)
+		assert.True(t, IsNotAllowedError(err))
+	})
+}
@@ -0,0 +1,27 @@
+package game
+
+type IllegalMoveError struct {
+	error
+}
+
+func NewIllegalMoveError(err error) *IllegalMoveError {
+	return &IllegalMoveError{err}
+}
+
+func IsIllegalMoveError(err error) bool {
+	_, ok := err.(*IllegalMoveError)
+	return ok
+}
+
+type NotAllowedError struct {
+	error
+}
+
+func NewNotAllowedError(err error) *NotAllowedError {
+	return &NotAllowedError{err}
+}
+
+func IsNotAllowedError(err error) bool {
+	_, ok := err.(*NotAllowedError)
+	return ok
+}
@@ -0,0 +1,116 @@
+package game
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
+)
+
+const (
+	DEFAULT_GAMES_LIMIT = 20
+	MAX_GAMES_LIMIT     = 100
+)
+
+func (s *service) GetGames(ctx context.Context, request GetGamesRequest) (*Games, error) {
+	limit := request.Limit
+	if limit <= 0 {
+		limit = DEFAULT_GAMES_LIMIT
+	} else if limit > MAX_GAMES_LIMIT {
+		limit = MAX_GAMES_LIMIT
+	}
+
+	if request.OpponentID == request.UserID {
+		return nil, NewNotAllowedError(fmt.Errorf("cannot play against yourself"))
+	}
+
+	/*
+		The matchup of the two players is queried on when there is an opponent,
+		which already only matches the games of the user. The results are
+		equality filters, see firestore.indexes.json for the indexes of each.
+	*/
+	query := s.getGamesRef().Query
+	if request.OpponentID != "" {
+		query = query.Where("matchup", "==", matchup(request.UserID, request.OpponentID))
+	} else {
+		query = query.Where("players", "array-contains", request.UserID)
+	}
+
+	switch request.Result {
+	case WIN:
+		query = query.Where("winner_id", "==", request.UserID)
+	case LOSS:
+		query = query.Where("loser_id", "==", request.UserID)
+	case DRAW:
+		query = query.Where("draw", "==", true)
+	case INGAME:
+		query = query.Where("ended", "==", false)
+	case "":
+		break
+	default:
+		return nil, NewNotAllowedError(fmt.Errorf("unknown result: %s", request.Result))
+	}
+
+	if request.Type != "" {
+		query = query.Where("type", "==", request.Type)
+	}
+	if request.TimeLimit != 0 {
+		query = query.Where("time_limit", "==", request.TimeLimit)
+	}
+	if !request.From.IsZero() {
+		query = query.Where("timestamp", ">=", request.From)
+	}
+	if !request.To.IsZero() {
+		query = query.Where("timestamp", "<", request.To)
+	}
+	query = query.
+		OrderBy("timestamp", firestore.Desc).
+		OrderBy("id", firestore.Asc)
+
+	if request.Cursor != "" {
+		cursor, err := firestore.DecodeCursor(request.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		if cursor != nil && cursor.After != nil && cursor.Current != nil {
+			query = query.StartAt(*cursor.After, *cursor.Current)
+		}
+	}
+
+	// the extra game is the first one of the next page
+	gameSnaps, err := query.
+		Limit(limit + 1).
+		Documents(ctx).
+		GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	games := make([]*Game, 0)
+	var next string
+	for i, gameSnap := range gameSnaps {
+		var game GameDocument
+		err = gameSnap.DataTo(&game)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == limit {
+			current := game.ID.String()
+			cursor := firestore.Cursor{
+				Current: &current,
+				After:   &game.Timestamp,
+			}
+			next = cursor.Encode()
+			break
+		}
+
+		games = append(games, s.populateGame(&game))
+	}
+
+	return &Games{
+		Games: games,
+		Next:  next,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package game
+
+import (
+	"context"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
+)
+
+const (
+	DEFAULT_LOBBY_LIMIT = 20
+	MAX_LOBBY_LIMIT     = 100
+)
+
+func (s *service) GetLobby(ctx context.Context, request GetLobbyRequest) (*Games, error) {
+	limit := request.Limit
+	if limit <= 0 {
+		limit = DEFAULT_LOBBY_LIMIT
+	} else if limit > MAX_LOBBY_LIMIT {
+		limit = MAX_LOBBY_LIMIT
+	}
+
+	query := s.getGamesRef().Where("open", "==", true)
+	if request.Type != "" {
+		query = query.Where("type", "==", request.Type)
+	}
+	if request.TimeLimit != 0 {
+		query = query.Where("time_limit", "==", request.TimeLimit)
+	}
+	query = query.
+		OrderBy("timestamp", firestore.Desc).
+		OrderBy("id", firestore.Asc)
+
+	if request.Cursor != "" {
+		cursor, err := firestore.DecodeCursor(request.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		if cursor != nil && cursor.After != nil && cursor.Current != nil {
+			query = query.StartAt(*cursor.After, *cursor.Current)
+		}
+	}
+
+	// the extra game is the first one of the next page
+	gameSnaps, err := query.
+		Limit(limit + 1).
+		Documents(ctx).
+		GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	games := make([]*Game, 0)
+	var next string
+	for i, gameSnap := range gameSnaps {
+		var game GameDocument
+		err = gameSnap.DataTo(&game)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == limit {
+			current := game.ID.String()
+			cursor := firestore.Cursor{
+				Current: &current,
+				After:   &game.Timestamp,
+			}
+			next = cursor.Encode()
+			break
+		}
+
+		games = append(games, s.populateGame(&game))
+	}
+
+	return &Games{
+		Games: games,
+		Next:  next,
+	}, nil
+}
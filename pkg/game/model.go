@@ -7,11 +7,15 @@ import (
 	"time"
 
 	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/garlicgarrison/chessvars-backend/pkg/stats"
 )
 
 type MoveNotation string
 
-const MOVE_REGEX string = "^[a-i]([1-9]|10)[a-i]([1-9]|10)$"
+const (
+	MOVE_REGEX     string = "^[a-i]([1-9]|10)[a-i]([1-9]|10)$"
+	USI_MOVE_REGEX string = `^([1-9][a-i][1-9][a-i]\+?|[PLNSGBR]\*[1-9][a-i])$`
+)
 
 type Move struct {
 	Move      MoveNotation `firestore:"move"`
@@ -34,6 +38,20 @@ func ParseMoveNotation(smove string) (MoveNotation, error) {
 	return MoveNotation(smove), nil
 }
 
+// ParseUSIMoveNotation parses shogi moves written in USI notation,
+// i.e. "7g7f", "8h2b+" for promotions and "P*5e" for drops
+func ParseUSIMoveNotation(smove string) (MoveNotation, error) {
+	ok, err := regexp.Match(USI_MOVE_REGEX, bytes.NewBufferString(smove).Bytes())
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", fmt.Errorf("not a valid move")
+	}
+
+	return MoveNotation(smove), nil
+}
+
 type GameType string
 
 const (
@@ -57,6 +75,18 @@ const (
 	RAPID4 TimeLimit = 30
 )
 
+// JOIN_TIMEOUT is how long a game waits for an opponent before
+// it is aborted by the sweeper
+const JOIN_TIMEOUT = 10 * time.Minute
+
+// CLAIM_VICTORY_GRACE is how long a player can be away from a game
+// in progress before its opponent can claim the win
+const CLAIM_VICTORY_GRACE = time.Minute
+
+// ABORT_MOVES is how many moves are made before a game can no longer
+// be aborted, which is the first move of each side
+const ABORT_MOVES = 2
+
 type GameStatus string
 
 const (
@@ -67,6 +97,23 @@ const (
 	Aborted GameStatus = "aborted"
 )
 
+// EndReason records how a finished game was decided
+type EndReason string
+
+const (
+	CHECKMATE  EndReason = "checkmate"
+	STALEMATE  EndReason = "stalemate"
+	BIKJANG    EndReason = "bikjang"
+	REPETITION EndReason = "repetition"
+	// PERPETUAL_CHECK is a shogi repetition lost by the checking side
+	PERPETUAL_CHECK EndReason = "perpetual_check"
+	RESIGNATION     EndReason = "resignation"
+	AGREEMENT       EndReason = "agreement"
+	TIMEOUT         EndReason = "timeout"
+	// ABANDONMENT is a win claimed over a player that left the game
+	ABANDONMENT EndReason = "abandonment"
+)
+
 // NOTE: In janggi, the game always starts with red
 type GameDocument struct {
 	ID        format.GameID `firestore:"id"`
@@ -74,10 +121,168 @@ type GameDocument struct {
 	PlayerOne format.UserID `firestore:"player_one"`
 	PlayerTwo format.UserID `firestore:"player_two"`
 	Moves     []Move        `firestore:"moves"`
-	Draw      bool          `firestore:"draw"`
-	Aborted   bool          `firestore:"aborted"`
-	Type      GameType      `firestore:"type"`
-	TimeLimit TimeLimit     `firestore:"time_limit"`
-	StartTime time.Time     `firestore:"start_time"`
-	Timestamp time.Time     `firestore:"timestamp"`
+	// Position is the current position in the notation of the variant
+	Position  string    `firestore:"position"`
+	Draw      bool      `firestore:"draw"`
+	Aborted   bool      `firestore:"aborted"`
+	EndReason EndReason `firestore:"end_reason"`
+	// DrawOffer is the player waiting on the opponent to agree to a draw
+	DrawOffer format.UserID `firestore:"draw_offer"`
+	// TakebackOffer is the player waiting on the opponent to let it take
+	// back its last move, and TakebackTime is when the last one was
+	TakebackOffer format.UserID `firestore:"takeback_offer"`
+	TakebackTime  time.Time     `firestore:"takeback_time"`
+	Type          GameType      `firestore:"type"`
+	TimeLimit     TimeLimit     `firestore:"time_limit"`
+	// StartTime is set once both players have joined
+	StartTime time.Time `firestore:"start_time"`
+	// PlayerOneAway and PlayerTwoAway are when each player lost its last
+	// connection to the game while it was going, zero while it is there
+	PlayerOneAway time.Time `firestore:"player_one_away"`
+	PlayerTwoAway time.Time `firestore:"player_two_away"`
+	// TimeControl takes over from TimeLimit, which is kept as the preset
+	// the game was created with if any
+	TimeControl TimeControl `firestore:"time_control"`
+	// PlayerOneClock and PlayerTwoClock are the remaining times of each
+	// player as of their last move, see Clocks for the live values
+	PlayerOneClock Clock `firestore:"player_one_clock"`
+	PlayerTwoClock Clock `firestore:"player_two_clock"`
+	// Deadline is when the game has to be swept unless something happens,
+	// either the flag of the side to move or the end of JOIN_TIMEOUT.
+	// It is nil for finished and untimed games.
+	Deadline *time.Time `firestore:"deadline"`
+	// PlayerOneEloDelta and PlayerTwoEloDelta are the rating changes
+	// of each player once the game is over
+	PlayerOneEloDelta int `firestore:"player_one_elo_delta"`
+	PlayerTwoEloDelta int `firestore:"player_two_elo_delta"`
+	// PlayerOneElo and PlayerTwoElo are the ratings of each player
+	// before the game, once it is over
+	PlayerOneElo int `firestore:"player_one_elo"`
+	PlayerTwoElo int `firestore:"player_two_elo"`
+	// Open is true while the game is waiting for a second player,
+	// which is what the lobby lists
+	Open bool `firestore:"open"`
+	// Players, Matchup, LoserID and Ended are what the game history
+	// of a user is queried on
+	Players   []format.UserID `firestore:"players"`
+	Matchup   string          `firestore:"matchup"`
+	LoserID   format.UserID   `firestore:"loser_id"`
+	Ended     bool            `firestore:"ended"`
+	Timestamp time.Time       `firestore:"timestamp"`
+}
+
+// matchup is the same for both orders of the two players
+func matchup(userID, otherUserID format.UserID) string {
+	if otherUserID < userID {
+		userID, otherUserID = otherUserID, userID
+	}
+	return userID.String() + "_" + otherUserID.String()
+}
+
+// abort aborts the game for the user, which has to be playing it.
+// Once both sides have moved the game has to be resigned instead,
+// so that nobody can abort a lost game to keep its rating.
+func (g *GameDocument) abort(userID format.UserID) error {
+	if userID == "" || userID != g.PlayerOne && userID != g.PlayerTwo {
+		return NewNotAllowedError(fmt.Errorf("not playing this game"))
+	}
+	if g.ended() {
+		return NewNotAllowedError(fmt.Errorf("game is over"))
+	}
+	if len(g.Moves) >= ABORT_MOVES {
+		return NewNotAllowedError(fmt.Errorf("game can no longer be aborted, resign instead"))
+	}
+
+	g.Aborted = true
+	return nil
+}
+
+// join seats the user in the empty seat of the game, which cannot be
+// taken by the player already seated
+func (g *GameDocument) join(userID format.UserID) error {
+	if g.Aborted {
+		return fmt.Errorf("game was aborted")
+	}
+	if userID == g.PlayerOne || userID == g.PlayerTwo {
+		return NewNotAllowedError(fmt.Errorf("cannot play against yourself"))
+	}
+
+	if g.PlayerOne == "" {
+		g.PlayerOne = userID
+	} else if g.PlayerTwo == "" {
+		g.PlayerTwo = userID
+	} else {
+		return fmt.Errorf("game cannot be joined")
+	}
+
+	// the clock of player one starts once both players are in
+	if g.PlayerOne != "" && g.PlayerTwo != "" {
+		g.StartTime = time.Now()
+		g.resetClocks()
+	}
+
+	return nil
+}
+
+// results returns the game from the point of view of each player,
+// which is nil unless it was played to a result
+func (g *GameDocument) results() []stats.Result {
+	if g.Aborted || !g.ended() || g.PlayerOne == "" || g.PlayerTwo == "" {
+		return nil
+	}
+
+	playerOne, playerTwo := stats.DRAW, stats.DRAW
+	switch g.WinnerID {
+	case g.PlayerOne:
+		playerOne, playerTwo = stats.WIN, stats.LOSS
+	case g.PlayerTwo:
+		playerOne, playerTwo = stats.LOSS, stats.WIN
+	}
+
+	return []stats.Result{
+		{
+			UserID:      g.PlayerOne,
+			Game:        stats.GameType(g.Type),
+			Side:        stats.PLAYER_ONE,
+			Status:      playerOne,
+			Elo:         g.PlayerOneElo,
+			OpponentElo: g.PlayerTwoElo,
+		},
+		{
+			UserID:      g.PlayerTwo,
+			Game:        stats.GameType(g.Type),
+			Side:        stats.PLAYER_TWO,
+			Status:      playerTwo,
+			Elo:         g.PlayerTwoElo,
+			OpponentElo: g.PlayerOneElo,
+		},
+	}
+}
+
+// refresh works out the fields that are derived from the rest of the
+// game so that they can be queried, which has to be done before saving
+func (g *GameDocument) refresh() {
+	g.Open = !g.ended() && (g.PlayerOne == "") != (g.PlayerTwo == "")
+	g.Ended = g.ended()
+
+	g.Players = make([]format.UserID, 0, 2)
+	for _, player := range []format.UserID{g.PlayerOne, g.PlayerTwo} {
+		if player != "" {
+			g.Players = append(g.Players, player)
+		}
+	}
+
+	g.Matchup, g.LoserID = "", ""
+	if len(g.Players) == 2 {
+		g.Matchup = matchup(g.PlayerOne, g.PlayerTwo)
+
+		switch g.WinnerID {
+		case g.PlayerOne:
+			g.LoserID = g.PlayerTwo
+		case g.PlayerTwo:
+			g.LoserID = g.PlayerOne
+		}
+	}
+
+	g.updateDeadline()
 }
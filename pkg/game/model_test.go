@@ -0,0 +1,199 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/garlicgarrison/chessvars-backend/pkg/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefreshOpen(t *testing.T) {
+	tests := []struct {
+		name string
+		game GameDocument
+		open bool
+	}{
+		{name: "player one waiting", game: GameDocument{PlayerOne: "a"}, open: true},
+		{name: "player two waiting", game: GameDocument{PlayerTwo: "a"}, open: true},
+		{name: "full", game: GameDocument{PlayerOne: "a", PlayerTwo: "b"}, open: false},
+		{name: "aborted", game: GameDocument{PlayerOne: "a", Aborted: true}, open: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.game.refresh()
+			assert.Equal(t, tt.open, tt.game.Open)
+		})
+	}
+}
+
+func TestJoin(t *testing.T) {
+	tests := []struct {
+		name    string
+		userID  format.UserID
+		game    GameDocument
+		allowed bool
+	}{
+		{name: "seat of player two", userID: "b", game: GameDocument{PlayerOne: "a"}, allowed: true},
+		{name: "seat of player one", userID: "b", game: GameDocument{PlayerTwo: "a"}, allowed: true},
+		{name: "own game", userID: "a", game: GameDocument{PlayerOne: "a"}, allowed: false},
+		{name: "own game as player two", userID: "a", game: GameDocument{PlayerTwo: "a"}, allowed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.game.join(tt.userID)
+			if tt.allowed {
+				assert.NoError(t, err)
+				assert.NotEqual(t, tt.game.PlayerOne, tt.game.PlayerTwo)
+				assert.False(t, tt.game.StartTime.IsZero())
+			} else {
+				assert.True(t, IsNotAllowedError(err))
+				assert.True(t, tt.game.StartTime.IsZero())
+			}
+		})
+	}
+
+	full := GameDocument{PlayerOne: "a", PlayerTwo: "b"}
+	assert.Error(t, full.join("c"))
+}
+
+func TestAbort(t *testing.T) {
+	moves := func(n int) []Move {
+		return make([]Move, n)
+	}
+
+	tests := []struct {
+		name    string
+		userID  format.UserID
+		game    GameDocument
+		allowed bool
+	}{
+		{name: "nobody joined", userID: "a", game: GameDocument{PlayerOne: "a"}, allowed: true},
+		{name: "no moves", userID: "a", game: GameDocument{PlayerOne: "a", PlayerTwo: "b"}, allowed: true},
+		{name: "before the first move of the opponent", userID: "a", game: GameDocument{PlayerOne: "a", PlayerTwo: "b", Moves: moves(1)}, allowed: true},
+		{name: "after both sides moved", userID: "a", game: GameDocument{PlayerOne: "a", PlayerTwo: "b", Moves: moves(2)}, allowed: false},
+		{name: "late in the game", userID: "b", game: GameDocument{PlayerOne: "a", PlayerTwo: "b", Moves: moves(40)}, allowed: false},
+		{name: "not playing", userID: "c", game: GameDocument{PlayerOne: "a", PlayerTwo: "b"}, allowed: false},
+		{name: "no user", userID: "", game: GameDocument{PlayerOne: "a"}, allowed: false},
+		{name: "over", userID: "a", game: GameDocument{PlayerOne: "a", PlayerTwo: "b", WinnerID: "b"}, allowed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.game.abort(tt.userID)
+			if tt.allowed {
+				assert.NoError(t, err)
+				assert.True(t, tt.game.Aborted)
+			} else {
+				assert.True(t, IsNotAllowedError(err))
+				assert.False(t, tt.game.Aborted)
+			}
+		})
+	}
+}
+
+func TestRefreshHistory(t *testing.T) {
+	tests := []struct {
+		name    string
+		game    GameDocument
+		players []format.UserID
+		matchup string
+		loser   format.UserID
+		ended   bool
+	}{
+		{
+			name:    "waiting",
+			game:    GameDocument{PlayerTwo: "b"},
+			players: []format.UserID{"b"},
+		},
+		{
+			name:    "in game",
+			game:    GameDocument{PlayerOne: "b", PlayerTwo: "a"},
+			players: []format.UserID{"b", "a"},
+			matchup: "a_b",
+		},
+		{
+			name:    "player one won",
+			game:    GameDocument{PlayerOne: "a", PlayerTwo: "b", WinnerID: "a"},
+			players: []format.UserID{"a", "b"},
+			matchup: "a_b",
+			loser:   "b",
+			ended:   true,
+		},
+		{
+			name:    "draw",
+			game:    GameDocument{PlayerOne: "a", PlayerTwo: "b", Draw: true},
+			players: []format.UserID{"a", "b"},
+			matchup: "a_b",
+			ended:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.game.refresh()
+			assert.Equal(t, tt.players, tt.game.Players)
+			assert.Equal(t, tt.matchup, tt.game.Matchup)
+			assert.Equal(t, tt.loser, tt.game.LoserID)
+			assert.Equal(t, tt.ended, tt.game.Ended)
+		})
+	}
+}
+
+func TestResults(t *testing.T) {
+	t.Run("unfinished and aborted games have none", func(t *testing.T) {
+		assert.Nil(t, (&GameDocument{PlayerOne: "a", PlayerTwo: "b"}).results())
+		assert.Nil(t, (&GameDocument{PlayerOne: "a", PlayerTwo: "b", Aborted: true}).results())
+		assert.Nil(t, (&GameDocument{PlayerOne: "a", WinnerID: "a"}).results())
+	})
+
+	t.Run("player two won", func(t *testing.T) {
+		game := GameDocument{
+			PlayerOne:    "a",
+			PlayerTwo:    "b",
+			WinnerID:     "b",
+			Type:         JANGGI,
+			PlayerOneElo: 1300,
+			PlayerTwoElo: 1200,
+		}
+
+		assert.Equal(t, []stats.Result{
+			{UserID: "a", Game: "janggi", Side: stats.PLAYER_ONE, Status: stats.LOSS, Elo: 1300, OpponentElo: 1200},
+			{UserID: "b", Game: "janggi", Side: stats.PLAYER_TWO, Status: stats.WIN, Elo: 1200, OpponentElo: 1300},
+		}, game.results())
+	})
+
+	t.Run("draw", func(t *testing.T) {
+		game := GameDocument{PlayerOne: "a", PlayerTwo: "b", Draw: true, Type: SHOGI}
+
+		for _, result := range game.results() {
+			assert.Equal(t, stats.DRAW, result.Status)
+		}
+	})
+}
+
+func TestAway(t *testing.T) {
+	now := time.Date(2022, 8, 1, 12, 0, 0, 0, time.UTC)
+	game := GameDocument{PlayerOne: "a", PlayerTwo: "b"}
+
+	assert.True(t, IsNotAllowedError(game.claimable("b", now)))
+
+	assert.True(t, game.setAway("b", true, now))
+	assert.Equal(t, now, game.PlayerTwoAway)
+	assert.True(t, game.PlayerOneAway.IsZero())
+
+	// going away again keeps the time it first left
+	assert.False(t, game.setAway("b", true, now.Add(30*time.Second)))
+	assert.Equal(t, now, game.PlayerTwoAway)
+
+	assert.True(t, IsNotAllowedError(game.claimable("b", now.Add(CLAIM_VICTORY_GRACE-time.Second))))
+	assert.NoError(t, game.claimable("b", now.Add(CLAIM_VICTORY_GRACE)))
+
+	assert.True(t, game.setAway("b", false, now.Add(CLAIM_VICTORY_GRACE)))
+	assert.True(t, game.PlayerTwoAway.IsZero())
+	assert.False(t, game.setAway("b", false, now.Add(CLAIM_VICTORY_GRACE)))
+	assert.True(t, IsNotAllowedError(game.claimable("b", now.Add(2*CLAIM_VICTORY_GRACE))))
+}
@@ -0,0 +1,165 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+)
+
+// opponent returns the other player of the game, and
+// an error if the user is not playing it
+func (g *GameDocument) opponent(userID format.UserID) (format.UserID, error) {
+	switch {
+	case userID == "":
+		break
+	case userID == g.PlayerOne && g.PlayerTwo != "":
+		return g.PlayerTwo, nil
+	case userID == g.PlayerTwo && g.PlayerOne != "":
+		return g.PlayerOne, nil
+	}
+
+	return "", NewNotAllowedError(fmt.Errorf("not playing this game"))
+}
+
+// playGameAction runs fn on a game in progress of the user, once
+// the clock of the side to move is known to still be running
+func (s *service) playGameAction(ctx context.Context, request GameActionRequest, fn func(*firestore.Transaction, *GameDocument, format.UserID) error) (*Game, error) {
+	now := time.Now()
+
+	game, err := s.updateGame(ctx, request.GameID, func(t *firestore.Transaction, game *GameDocument) error {
+		otherUserID, err := game.opponent(request.UserID)
+		if err != nil {
+			return err
+		}
+
+		if game.ended() {
+			return NewNotAllowedError(fmt.Errorf("game is over"))
+		}
+
+		// the game is over on time before anything else can happen
+		flagged, err := s.flagFall(ctx, t, game, now)
+		if err != nil || flagged {
+			return err
+		}
+
+		return fn(t, game, otherUserID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.populateGame(game), nil
+}
+
+func (s *service) ResignGame(ctx context.Context, request GameActionRequest) (*EditGameResponse, error) {
+	return s.playGameAction(ctx, request, func(t *firestore.Transaction, game *GameDocument, otherUserID format.UserID) error {
+		return s.finishGame(ctx, t, game, request.UserID, otherUserID, LOSS, RESIGNATION)
+	})
+}
+
+func (s *service) OfferDraw(ctx context.Context, request GameActionRequest) (*EditGameResponse, error) {
+	return s.playGameAction(ctx, request, func(t *firestore.Transaction, game *GameDocument, otherUserID format.UserID) error {
+		if game.DrawOffer == otherUserID {
+			return s.finishGame(ctx, t, game, request.UserID, otherUserID, DRAW, AGREEMENT)
+		}
+
+		game.DrawOffer = request.UserID
+		return nil
+	})
+}
+
+func (s *service) RespondDraw(ctx context.Context, request RespondOfferRequest) (*EditGameResponse, error) {
+	action := GameActionRequest{UserID: request.UserID, GameID: request.GameID}
+	return s.playGameAction(ctx, action, func(t *firestore.Transaction, game *GameDocument, otherUserID format.UserID) error {
+		if game.DrawOffer != otherUserID {
+			return NewNotAllowedError(fmt.Errorf("no draw was offered"))
+		}
+
+		if request.Accept {
+			return s.finishGame(ctx, t, game, request.UserID, otherUserID, DRAW, AGREEMENT)
+		}
+
+		game.DrawOffer = ""
+		return nil
+	})
+}
+
+// takebackMoves is how many moves are taken back for the user,
+// which is its last move and any reply of the opponent to it
+func (g *GameDocument) takebackMoves(userID format.UserID) int {
+	toMove := g.PlayerOne
+	if len(g.Moves)%2 == 1 {
+		toMove = g.PlayerTwo
+	}
+
+	if userID == toMove {
+		return 2
+	}
+	return 1
+}
+
+func (s *service) RequestTakeback(ctx context.Context, request GameActionRequest) (*EditGameResponse, error) {
+	return s.playGameAction(ctx, request, func(t *firestore.Transaction, game *GameDocument, otherUserID format.UserID) error {
+		if game.takebackMoves(request.UserID) > len(game.Moves) {
+			return NewNotAllowedError(fmt.Errorf("no move to take back"))
+		}
+		if game.TakebackOffer == otherUserID {
+			return NewNotAllowedError(fmt.Errorf("opponent already asked for a takeback"))
+		}
+
+		game.TakebackOffer = request.UserID
+		return nil
+	})
+}
+
+func (s *service) RespondTakeback(ctx context.Context, request RespondOfferRequest) (*EditGameResponse, error) {
+	now := time.Now()
+
+	action := GameActionRequest{UserID: request.UserID, GameID: request.GameID}
+	return s.playGameAction(ctx, action, func(t *firestore.Transaction, game *GameDocument, otherUserID format.UserID) error {
+		if game.TakebackOffer != otherUserID {
+			return NewNotAllowedError(fmt.Errorf("no takeback was asked for"))
+		}
+		game.TakebackOffer = ""
+
+		if !request.Accept {
+			return nil
+		}
+
+		return game.takeback(otherUserID, now)
+	})
+}
+
+// takeback undoes the last move of the user at now, where the time the
+// side to move has spent stays spent and the clock restarts for the other
+func (g *GameDocument) takeback(userID format.UserID, now time.Time) error {
+	n := g.takebackMoves(userID)
+	if n > len(g.Moves) {
+		return NewNotAllowedError(fmt.Errorf("no move to take back"))
+	}
+
+	variant, err := GetVariant(g.Type)
+	if err != nil {
+		return err
+	}
+
+	if g.timed() && g.started() {
+		clock := g.clock()
+		*clock = g.timeControl().pause(*clock, now.Sub(g.clockStart()))
+	}
+
+	moves := g.Moves[:len(g.Moves)-n]
+	pos, err := replay(variant, moves)
+	if err != nil {
+		return err
+	}
+
+	g.Moves = moves
+	g.Position = pos.String()
+	g.DrawOffer = ""
+	g.TakebackTime = now
+	return nil
+}
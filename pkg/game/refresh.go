@@ -0,0 +1,39 @@
+package game
+
+import (
+	"context"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"google.golang.org/api/iterator"
+)
+
+func (s *service) RefreshGames(ctx context.Context, request RefreshGamesRequest) (*RefreshGamesResponse, error) {
+	refreshed := 0
+
+	iter := s.getGamesRef().Documents(ctx)
+	defer iter.Stop()
+	for {
+		gameSnap, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// each game is saved in its own transaction, so games
+		// played while the backfill runs are not written over
+		_, err = s.updateGame(ctx, format.GameID(gameSnap.Ref.ID), func(*firestore.Transaction, *GameDocument) error {
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		refreshed++
+	}
+
+	return &RefreshGamesResponse{
+		Games: refreshed,
+	}, nil
+}
@@ -11,18 +11,21 @@ import (
 	"github.com/garlicgarrison/chessvars-backend/pkg/elo"
 	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
 	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/garlicgarrison/chessvars-backend/pkg/stats"
 )
 
 type Config struct {
 	Firestore firestore.Firestore
 
-	EloService elo.Service
+	EloService   elo.Service
+	StatsService stats.Service
 }
 
 type service struct {
 	fs firestore.Firestore
 
-	elo elo.Service
+	elo   elo.Service
+	stats stats.Service
 }
 
 func NewService(cfg Config) (Service, error) {
@@ -31,8 +34,9 @@ func NewService(cfg Config) (Service, error) {
 	}
 
 	return &service{
-		fs:  cfg.Firestore,
-		elo: cfg.EloService,
+		fs:    cfg.Firestore,
+		elo:   cfg.EloService,
+		stats: cfg.StatsService,
 	}, nil
 }
 
@@ -42,7 +46,7 @@ func (s *service) populateGame(game *GameDocument) *Game {
 		moves = append(moves, MoveResponse(m))
 	}
 
-	log.Printf("moves %v %v", game.Moves, moves)
+	playerOneClock, playerTwoClock := game.Clocks(time.Now())
 
 	return &Game{
 		ID:        game.ID,
@@ -52,14 +56,32 @@ func (s *service) populateGame(game *GameDocument) *Game {
 		Moves:     moves,
 		Draw:      game.Draw,
 		Aborted:   game.Aborted,
-		TimeLimit: game.TimeLimit,
-		Type:      game.Type,
-		Timestamp: game.Timestamp,
+		EndReason: game.EndReason,
+		DrawOffer: game.DrawOffer,
+
+		TakebackOffer: game.TakebackOffer,
+		Position:      game.Position,
+		TimeLimit:     game.TimeLimit,
+		Type:          game.Type,
+		StartTime:     game.StartTime,
+
+		TimeControl: game.timeControl(),
+		Timestamp:   game.Timestamp,
+
+		PlayerOneClock: playerOneClock,
+		PlayerTwoClock: playerTwoClock,
+
+		PlayerOneEloDelta: game.PlayerOneEloDelta,
+		PlayerTwoEloDelta: game.PlayerTwoEloDelta,
+
+		PlayerOneAway: game.PlayerOneAway,
+		PlayerTwoAway: game.PlayerTwoAway,
 	}
 }
 
 func (s *service) validateMove(userID format.UserID, game *GameDocument) bool {
-	if game.PlayerOne == userID && len(game.Moves)%2 != 0 ||
+	if userID == "" || game.PlayerOne != userID && game.PlayerTwo != userID ||
+		game.PlayerOne == userID && len(game.Moves)%2 != 0 ||
 		game.PlayerTwo == userID && len(game.Moves)%2 != 1 ||
 		game.Aborted ||
 		game.Draw ||
@@ -73,28 +95,56 @@ func (s *service) CreateGame(ctx context.Context, request CreateGameRequest) (*C
 	gameID := format.NewGameID()
 	now := time.Now()
 
+	variant, err := GetVariant(request.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.OpponentID == request.UserID {
+		return nil, NewNotAllowedError(fmt.Errorf("cannot play against yourself"))
+	}
+
+	timeControl := request.TimeLimit.TimeControl()
+	if request.TimeControl != nil {
+		err = request.TimeControl.Validate()
+		if err != nil {
+			return nil, err
+		}
+		timeControl = *request.TimeControl
+	}
+
 	gameDoc := GameDocument{
 		ID:        gameID,
 		Moves:     make([]Move, 0),
+		Position:  variant.NewPosition().String(),
 		TimeLimit: request.TimeLimit,
+		Type:      request.Type,
 		Timestamp: now,
-	}
 
-	switch request.Type {
-	case JANGGI:
-		gameDoc.Type = JANGGI
-	case SHOGI:
-		gameDoc.Type = SHOGI
+		TimeControl: timeControl,
 	}
+	gameDoc.resetClocks()
 
 	// Decides if user if player 1 or player 2 randomly
-	if rand.Intn(2) == 0 {
+	side := Side(rand.Intn(2))
+	if request.Side != nil {
+		side = *request.Side
+	}
+
+	if side == PLAYER_ONE {
 		gameDoc.PlayerOne = request.UserID
+		gameDoc.PlayerTwo = request.OpponentID
 	} else {
+		gameDoc.PlayerOne = request.OpponentID
 		gameDoc.PlayerTwo = request.UserID
 	}
 
-	_, err := s.getGameRef(gameID).Create(ctx, gameDoc)
+	if request.OpponentID != "" {
+		gameDoc.StartTime = now
+	}
+	gameDoc.refresh()
+
+	_, err = s.getGameRef(gameID).Create(ctx, gameDoc)
 	if err != nil {
 		return nil, err
 	}
@@ -117,12 +167,76 @@ func (s *service) GetGame(ctx context.Context, request GetGameRequest) (*GetGame
 	return s.populateGame(&game), nil
 }
 
-func (s *service) EditGame(ctx context.Context, request EditGameRequest) (*EditGameResponse, error) {
-	now := time.Now()
+// finishGame records the result of the game from the point of view of userID,
+// rating both players and adding it to their stats in t along with it
+func (s *service) finishGame(ctx context.Context, t *firestore.Transaction, game *GameDocument, userID, otherUserID format.UserID, status GameStatus, reason EndReason) error {
+	game.EndReason = reason
+	game.DrawOffer = ""
+
+	switch status {
+	case WIN:
+		game.WinnerID = userID
+	case LOSS:
+		game.WinnerID = otherUserID
+	case DRAW:
+		game.Draw = true
+	}
 
+	// nobody to be rated against
+	if userID == "" || otherUserID == "" {
+		return nil
+	}
+
+	ratings, err := s.elo.UpdateElos(ctx, t, elo.UpdateElosRequest{
+		GameID:      game.ID,
+		UserID:      userID,
+		OtherUserID: otherUserID,
+		Game:        elo.GameType(game.Type),
+		Category:    game.timeControl().Category(),
+		Status:      elo.GameStatus(status),
+	})
+	if err != nil {
+		return err
+	}
+
+	before, otherBefore := ratings.Elo.Elo-ratings.Delta, ratings.OtherElo.Elo-ratings.OtherDelta
+	if userID == game.PlayerOne {
+		game.PlayerOneEloDelta, game.PlayerTwoEloDelta = ratings.Delta, ratings.OtherDelta
+		game.PlayerOneElo, game.PlayerTwoElo = before, otherBefore
+	} else {
+		game.PlayerOneEloDelta, game.PlayerTwoEloDelta = ratings.OtherDelta, ratings.Delta
+		game.PlayerOneElo, game.PlayerTwoElo = otherBefore, before
+	}
+
+	return s.stats.RecordGame(ctx, t, stats.RecordGameRequest{
+		Results: game.results(),
+	})
+}
+
+// syncElos mirrors the ratings of a game that was just rated to the
+// leaderboard, which only logs on failure as the game is already saved
+func (s *service) syncElos(ctx context.Context, game *GameDocument) {
+	if game.Aborted || game.PlayerOne == "" || game.PlayerTwo == "" {
+		return
+	}
+
+	err := s.elo.SyncElos(ctx, elo.SyncElosRequest{
+		UserIDs:  []format.UserID{game.PlayerOne, game.PlayerTwo},
+		Game:     elo.GameType(game.Type),
+		Category: game.timeControl().Category(),
+	})
+	if err != nil {
+		log.Printf("failed to sync elos of game %s: %s", game.ID, err)
+	}
+}
+
+// updateGame runs fn on the game inside a transaction and saves it
+// with its derived fields worked out again
+func (s *service) updateGame(ctx context.Context, gameID format.GameID, fn func(*firestore.Transaction, *GameDocument) error) (*GameDocument, error) {
 	var game GameDocument
+	var ended bool
 	err := s.fs.RunTransaction(ctx, func(_ context.Context, t *firestore.Transaction) error {
-		gameSnap, err := t.Get(s.getGameRef(request.GameID))
+		gameSnap, err := t.Get(s.getGameRef(gameID))
 		if err != nil {
 			return err
 		}
@@ -131,17 +245,74 @@ func (s *service) EditGame(ctx context.Context, request EditGameRequest) (*EditG
 		if err != nil {
 			return err
 		}
+		ended = game.ended()
+
+		err = fn(t, &game)
+		if err != nil {
+			return err
+		}
+
+		game.refresh()
+		return t.Set(
+			s.getGameRef(gameID),
+			game,
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !ended && game.ended() {
+		s.syncElos(ctx, &game)
+	}
+
+	return &game, nil
+}
+
+// flagFall ends the game as a loss on time for the side to move
+// if its clock has run out at now
+func (s *service) flagFall(ctx context.Context, t *firestore.Transaction, game *GameDocument, now time.Time) (bool, error) {
+	if !game.flagged(now) {
+		return false, nil
+	}
+
+	*game.clock() = Clock{}
+
+	mover, other := game.PlayerOne, game.PlayerTwo
+	if len(game.Moves)%2 == 1 {
+		mover, other = other, mover
+	}
+	return true, s.finishGame(ctx, t, game, mover, other, LOSS, TIMEOUT)
+}
+
+func (s *service) EditGame(ctx context.Context, request EditGameRequest) (*EditGameResponse, error) {
+	if request.Status == WIN {
+		return nil, NewNotAllowedError(fmt.Errorf("a win can only be decided by the server"))
+	}
+
+	now := time.Now()
+
+	game, err := s.updateGame(ctx, request.GameID, func(t *firestore.Transaction, game *GameDocument) error {
+		// an abort can come on either turn, but only early on
+		if request.Status == Aborted {
+			return game.abort(request.UserID)
+		}
 
 		/*
 			This makes sure that a move is even allowed to be made.
-			Later, this should have a move validator to make sure a move is legal,
-			and to see if a move is a winning or drawing move with game logic.
-			For now, we can trust the client for game logic.
+			The legality of the move and whether it ends the game
+			is then decided by the rules of the variant.
 		*/
-		if !s.validateMove(request.UserID, &game) {
+		if !s.validateMove(request.UserID, game) {
 			return fmt.Errorf("move not validated")
 		}
 
+		// a move that arrives after the clock ran out loses on time
+		flagged, err := s.flagFall(ctx, t, game, now)
+		if err != nil || flagged {
+			return err
+		}
+
 		var otherUserID format.UserID
 		if game.PlayerOne == request.UserID {
 			otherUserID = game.PlayerTwo
@@ -149,94 +320,72 @@ func (s *service) EditGame(ctx context.Context, request EditGameRequest) (*EditG
 			otherUserID = game.PlayerOne
 		}
 
-		switch request.Status {
-		case LOSS:
-			game.WinnerID = otherUserID
-
-			// TODO: make a way to update even if fail
-			s.elo.UpdateElo(ctx, elo.UpdateEloRequest{
-				UserID:      request.UserID,
-				OtherUserID: otherUserID,
-				Game:        elo.GameType(game.Type),
-				Status:      elo.LOSS,
-			})
-		case WIN:
-			game.WinnerID = request.UserID
-
-			s.elo.UpdateElo(ctx, elo.UpdateEloRequest{
-				UserID:      request.UserID,
-				OtherUserID: otherUserID,
-				Game:        elo.GameType(game.Type),
-				Status:      elo.WIN,
-			})
-		case DRAW:
-			game.Draw = true
-
-			s.elo.UpdateElo(ctx, elo.UpdateEloRequest{
-				UserID:      request.UserID,
-				OtherUserID: otherUserID,
-				Game:        elo.GameType(game.Type),
-				Status:      elo.DRAW,
-			})
-		case Aborted:
-			game.Aborted = true
-		case INGAME:
-			break
+		status := INGAME
+		var reason EndReason
+		if request.Move != nil {
+			if !game.started() {
+				return fmt.Errorf("game has not started")
+			}
+
+			moves, moveStatus, moveReason, err := s.playRules(game, *request.Move)
+			if err != nil {
+				return err
+			}
+			status, reason = moveStatus, moveReason
+
+			// a forced reply takes no time, which leaves the clock
+			// of the opponent as it was
+			game.tick(now)
+			for _, move := range moves {
+				game.Moves = append(game.Moves, Move{
+					Move:      move,
+					Timestamp: now,
+				})
+			}
+
+			// playing on without accepting declines the opponent's draw offer
+			if game.DrawOffer == otherUserID && request.Status != DRAW {
+				game.DrawOffer = ""
+			}
+			// a takeback is only ever asked for the position it was asked in
+			game.TakebackOffer = ""
 		}
 
-		if request.Move != nil {
-			newMoves := append(game.Moves, Move{
-				Move:      *request.Move,
-				Timestamp: now,
-			})
-			game.Moves = newMoves
+		if status == INGAME {
+			switch request.Status {
+			case LOSS:
+				status, reason = LOSS, RESIGNATION
+			case DRAW:
+				if game.DrawOffer == otherUserID {
+					status, reason = DRAW, AGREEMENT
+				} else {
+					game.DrawOffer = request.UserID
+				}
+			case INGAME:
+				break
+			}
 		}
 
-		return t.Set(
-			s.getGameRef(request.GameID),
-			game,
-		)
+		if status != INGAME {
+			return s.finishGame(ctx, t, game, request.UserID, otherUserID, status, reason)
+		}
+
+		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return s.populateGame(&game), nil
+	return s.populateGame(game), nil
 }
 
 func (s *service) JoinGame(ctx context.Context, request JoinGameRequest) (*EditGameResponse, error) {
-	var game GameDocument
-	err := s.fs.RunTransaction(ctx, func(_ context.Context, t *firestore.Transaction) error {
-		gameSnap, err := t.Get(s.getGameRef(request.GameID))
-		if err != nil {
-			return err
-		}
-
-		err = gameSnap.DataTo(&game)
-		if err != nil {
-			return err
-		}
-
-		if game.Aborted {
-			return fmt.Errorf("game was aborted")
-		}
-
-		if game.PlayerOne == "" {
-			game.PlayerOne = request.UserID
-		} else if game.PlayerTwo == "" {
-			game.PlayerTwo = request.UserID
-		} else {
-			return fmt.Errorf("game cannot be joined")
-		}
-
-		return t.Set(
-			s.getGameRef(request.GameID),
-			game,
-		)
+	game, err := s.updateGame(ctx, request.GameID, func(_ *firestore.Transaction, game *GameDocument) error {
+		return game.join(request.UserID)
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return s.populateGame(&game), nil
+	return s.populateGame(game), nil
 }
@@ -0,0 +1,56 @@
+package game
+
+import (
+	"context"
+	"errors"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/stats"
+	"google.golang.org/api/iterator"
+)
+
+func (s *service) RebuildStats(ctx context.Context, request RebuildStatsRequest) (*RebuildStatsResponse, error) {
+	if s.stats == nil {
+		return nil, errors.New("stats service required")
+	}
+
+	tally := stats.Tally{}
+	counted := 0
+
+	iter := s.getGamesRef().Documents(ctx)
+	defer iter.Stop()
+	for {
+		gameSnap, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var game GameDocument
+		err = gameSnap.DataTo(&game)
+		if err != nil {
+			return nil, err
+		}
+
+		results := game.results()
+		for _, result := range results {
+			tally.Add(result)
+		}
+		if len(results) > 0 {
+			counted++
+		}
+	}
+
+	err := s.stats.RebuildStats(ctx, stats.RebuildStatsRequest{
+		Tally: tally,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RebuildStatsResponse{
+		Games: counted,
+		Users: len(tally),
+	}, nil
+}
@@ -0,0 +1,79 @@
+package game
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+)
+
+// SWEEP_LIMIT is the default number of games closed per sweep
+const SWEEP_LIMIT = 100
+
+func (s *service) SweepGames(ctx context.Context, request SweepGamesRequest) (*SweepGamesResponse, error) {
+	now := time.Now()
+
+	limit := request.Limit
+	if limit <= 0 {
+		limit = SWEEP_LIMIT
+	}
+
+	gameSnaps, err := s.getGamesRef().
+		Where("deadline", "<=", now).
+		Limit(limit).
+		Documents(ctx).
+		GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	swept := make([]*Game, 0)
+	for _, gameSnap := range gameSnaps {
+		gameID := format.GameID(gameSnap.Ref.ID)
+
+		game, err := s.sweepGame(ctx, gameID, now)
+		if err != nil {
+			log.Printf("[SweepGames] -- could not sweep game %s: %s", gameID, err)
+			continue
+		}
+		if game != nil {
+			swept = append(swept, game)
+		}
+	}
+
+	return &SweepGamesResponse{
+		Games: swept,
+	}, nil
+}
+
+// sweepGame aborts the game if nobody joined it in time or ends it on
+// time if the side to move has flagged, returning the game if it did
+// either and nil otherwise
+func (s *service) sweepGame(ctx context.Context, gameID format.GameID, now time.Time) (*Game, error) {
+	swept := false
+	game, err := s.updateGame(ctx, gameID, func(t *firestore.Transaction, game *GameDocument) error {
+		// the game may have moved on since it was queried
+		if game.ended() {
+			return nil
+		}
+
+		if !game.started() {
+			if now.Sub(game.Timestamp) >= JOIN_TIMEOUT {
+				game.Aborted = true
+				swept = true
+			}
+			return nil
+		}
+
+		var err error
+		swept, err = s.flagFall(ctx, t, game, now)
+		return err
+	})
+	if err != nil || !swept {
+		return nil, err
+	}
+
+	return s.populateGame(game), nil
+}
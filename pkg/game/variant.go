@@ -0,0 +1,223 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Side is the seat of a player in a game.
+//
+// NOTE: PLAYER_ONE always moves first (red in janggi, sente in shogi)
+type Side int
+
+const (
+	PLAYER_ONE Side = iota
+	PLAYER_TWO
+)
+
+func (s Side) Other() Side {
+	if s == PLAYER_ONE {
+		return PLAYER_TWO
+	}
+	return PLAYER_ONE
+}
+
+// Outcome is the state of a game after the last move.
+//
+// Reason is empty while the game is still going, and
+// Winner is only meaningful when the game is not a draw.
+type Outcome struct {
+	Reason EndReason
+	Draw   bool
+	Winner Side
+}
+
+func (o Outcome) Ended() bool {
+	return o.Reason != ""
+}
+
+// Variant is the rules of a GameType.
+//
+// Adding a new variant means implementing this interface
+// and registering it with RegisterVariant.
+type Variant interface {
+	// ParseMove checks that the move is written in the notation of the variant
+	// and returns its canonical notation, which is the one that gets stored
+	ParseMove(string) (MoveNotation, error)
+	// NewPosition returns the starting position
+	NewPosition() Position
+}
+
+// Position is a game of a Variant in progress
+type Position interface {
+	// Play applies the move if it is legal
+	Play(MoveNotation) error
+	// Turn returns the side to move
+	Turn() Side
+	// Outcome returns the state of the game after the last move
+	Outcome() Outcome
+	// Forced returns the move the side to move has to make when it has
+	// no choice, such as a pass in janggi, and false otherwise
+	Forced() (MoveNotation, bool)
+	// String returns the position in the FEN-like notation of the variant
+	String() string
+}
+
+var (
+	variantsLock sync.RWMutex
+	variants     = map[GameType]Variant{}
+)
+
+// RegisterVariant makes the rules of a game type available.
+// It panics if the game type was already registered.
+func RegisterVariant(gameType GameType, variant Variant) {
+	variantsLock.Lock()
+	defer variantsLock.Unlock()
+
+	if _, ok := variants[gameType]; ok {
+		panic(fmt.Sprintf("variant already registered: %s", gameType))
+	}
+	variants[gameType] = variant
+}
+
+func GetVariant(gameType GameType) (Variant, error) {
+	variantsLock.RLock()
+	defer variantsLock.RUnlock()
+
+	variant, ok := variants[gameType]
+	if !ok {
+		return nil, fmt.Errorf("game not implemented: %s", gameType)
+	}
+	return variant, nil
+}
+
+// ParseGameType returns the registered game type matching str,
+// regardless of case
+func ParseGameType(str string) (GameType, error) {
+	gameType := GameType(strings.ToLower(str))
+	_, err := GetVariant(gameType)
+	if err != nil {
+		return "", err
+	}
+
+	return gameType, nil
+}
+
+// GameTypes returns every registered game type
+func GameTypes() []GameType {
+	variantsLock.RLock()
+	defer variantsLock.RUnlock()
+
+	toRet := make([]GameType, 0, len(variants))
+	for gameType := range variants {
+		toRet = append(toRet, gameType)
+	}
+	sort.Slice(toRet, func(i, j int) bool {
+		return toRet[i] < toRet[j]
+	})
+	return toRet
+}
+
+// replay plays every stored move from the starting position
+func replay(variant Variant, moves []Move) (Position, error) {
+	pos := variant.NewPosition()
+	for i, m := range moves {
+		err := pos.Play(m.Move)
+		if err != nil {
+			return nil, fmt.Errorf("stored move %d: %w", i, err)
+		}
+	}
+
+	return pos, nil
+}
+
+// Before returns the game as it stood before the moves played at or
+// after t, which is the game itself when there are none
+func (g *Game) Before(t time.Time) (*Game, error) {
+	n := len(g.Moves)
+	for n > 0 && !g.Moves[n-1].Timestamp.Before(t) {
+		n--
+	}
+	if n == len(g.Moves) {
+		return g, nil
+	}
+
+	variant, err := GetVariant(g.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	moves := make([]Move, n)
+	for i := range moves {
+		moves[i] = Move{
+			Move:      g.Moves[i].Move,
+			Timestamp: g.Moves[i].Timestamp,
+		}
+	}
+	pos, err := replay(variant, moves)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *g
+	before.Moves = g.Moves[:n:n]
+	before.Position = pos.String()
+	return &before, nil
+}
+
+// playRules makes sure the move is written in the notation of the variant
+// and is legal in the current position.
+//
+// It returns the moves to add to the game, which is the move followed by
+// the one of the opponent when it is forced, and the status of the game
+// after them from the point of view of the player making the move, along
+// with the reason if the game has ended.
+func (s *service) playRules(game *GameDocument, move MoveNotation) ([]MoveNotation, GameStatus, EndReason, error) {
+	variant, err := GetVariant(game.Type)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	move, err = variant.ParseMove(move.String())
+	if err != nil {
+		return nil, "", "", NewIllegalMoveError(err)
+	}
+
+	pos, err := replay(variant, game.Moves)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	mover := pos.Turn()
+	err = pos.Play(move)
+	if err != nil {
+		return nil, "", "", NewIllegalMoveError(err)
+	}
+	moves := []MoveNotation{move}
+
+	// the opponent makes a forced move right away, so that the turn
+	// stays with the side the number of moves says it is
+	if forced, ok := pos.Forced(); ok && !pos.Outcome().Ended() {
+		err = pos.Play(forced)
+		if err != nil {
+			return nil, "", "", err
+		}
+		moves = append(moves, forced)
+	}
+	game.Position = pos.String()
+
+	outcome := pos.Outcome()
+	switch {
+	case !outcome.Ended():
+		return moves, INGAME, "", nil
+	case outcome.Draw:
+		return moves, DRAW, outcome.Reason, nil
+	case outcome.Winner == mover:
+		return moves, WIN, outcome.Reason, nil
+	default:
+		return moves, LOSS, outcome.Reason, nil
+	}
+}
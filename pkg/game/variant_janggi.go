@@ -0,0 +1,86 @@
+package game
+
+import (
+	"github.com/garlicgarrison/chessvars-backend/pkg/janggi"
+)
+
+func init() {
+	RegisterVariant(JANGGI, janggiVariant{})
+}
+
+type janggiVariant struct{}
+
+func (janggiVariant) ParseMove(smove string) (MoveNotation, error) {
+	_, err := ParseMoveNotation(smove)
+	if err != nil {
+		return "", err
+	}
+
+	m, err := janggi.ParseMove(smove)
+	if err != nil {
+		return "", err
+	}
+
+	return MoveNotation(m.String()), nil
+}
+
+func (janggiVariant) NewPosition() Position {
+	return &janggiPosition{
+		game: janggi.NewGame(),
+	}
+}
+
+type janggiPosition struct {
+	game *janggi.Game
+}
+
+func (p *janggiPosition) Play(move MoveNotation) error {
+	m, err := janggi.ParseMove(move.String())
+	if err != nil {
+		return err
+	}
+
+	return p.game.Play(m)
+}
+
+// Turn maps red to PLAYER_ONE since red always starts
+func (p *janggiPosition) Turn() Side {
+	if p.game.Position().Turn() == janggi.RED {
+		return PLAYER_ONE
+	}
+	return PLAYER_TWO
+}
+
+func (p *janggiPosition) Outcome() Outcome {
+	outcome := p.game.Outcome()
+
+	switch outcome.Result {
+	case janggi.CHECKMATE:
+		winner := PLAYER_ONE
+		if outcome.Winner == janggi.BLUE {
+			winner = PLAYER_TWO
+		}
+		return Outcome{Reason: CHECKMATE, Winner: winner}
+	case janggi.STALEMATE:
+		return Outcome{Reason: STALEMATE, Draw: true}
+	case janggi.BIKJANG:
+		return Outcome{Reason: BIKJANG, Draw: true}
+	case janggi.REPETITION:
+		return Outcome{Reason: REPETITION, Draw: true}
+	}
+
+	return Outcome{}
+}
+
+// Forced is a pass when the side to move has no move and is not in check
+func (p *janggiPosition) Forced() (MoveNotation, bool) {
+	pos := p.game.Position()
+	if !pos.MustPass() {
+		return "", false
+	}
+	return MoveNotation(pos.PassMove().String()), true
+}
+
+func (p *janggiPosition) String() string {
+	return p.game.Position().FEN()
+}
@@ -0,0 +1,83 @@
+package game
+
+import (
+	"github.com/garlicgarrison/chessvars-backend/pkg/shogi"
+)
+
+func init() {
+	RegisterVariant(SHOGI, shogiVariant{})
+}
+
+type shogiVariant struct{}
+
+func (shogiVariant) ParseMove(smove string) (MoveNotation, error) {
+	_, err := ParseUSIMoveNotation(smove)
+	if err != nil {
+		return "", err
+	}
+
+	m, err := shogi.ParseMove(smove)
+	if err != nil {
+		return "", err
+	}
+
+	return MoveNotation(m.String()), nil
+}
+
+func (shogiVariant) NewPosition() Position {
+	return &shogiPosition{
+		game: shogi.NewGame(),
+	}
+}
+
+type shogiPosition struct {
+	game *shogi.Game
+}
+
+func (p *shogiPosition) Play(move MoveNotation) error {
+	m, err := shogi.ParseMove(move.String())
+	if err != nil {
+		return err
+	}
+
+	return p.game.Play(m)
+}
+
+// Turn maps sente to PLAYER_ONE since sente always starts
+func (p *shogiPosition) Turn() Side {
+	if p.game.Position().Turn() == shogi.SENTE {
+		return PLAYER_ONE
+	}
+	return PLAYER_TWO
+}
+
+func (p *shogiPosition) Outcome() Outcome {
+	outcome := p.game.Outcome()
+
+	winner := PLAYER_ONE
+	if outcome.Winner == shogi.GOTE {
+		winner = PLAYER_TWO
+	}
+
+	switch outcome.Result {
+	case shogi.CHECKMATE:
+		return Outcome{Reason: CHECKMATE, Winner: winner}
+	case shogi.STALEMATE:
+		return Outcome{Reason: STALEMATE, Winner: winner}
+	case shogi.PERPETUAL_CHECK:
+		return Outcome{Reason: PERPETUAL_CHECK, Winner: winner}
+	case shogi.SENNICHITE:
+		return Outcome{Reason: REPETITION, Draw: true}
+	}
+
+	return Outcome{}
+}
+
+// Forced is never a move in shogi, where a side without one loses
+func (p *shogiPosition) Forced() (MoveNotation, bool) {
+	return "", false
+}
+
+func (p *shogiPosition) String() string {
+	return p.game.Position().SFEN()
+}
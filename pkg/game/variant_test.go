@@ -0,0 +1,99 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGameType(t *testing.T) {
+	for _, str := range []string{"JANGGI", "janggi", "SHOGI"} {
+		_, err := ParseGameType(str)
+		assert.NoError(t, err, str)
+	}
+
+	_, err := ParseGameType("chess")
+	assert.Error(t, err)
+}
+
+func TestVariantParseMove(t *testing.T) {
+	tests := []struct {
+		name     string
+		gameType GameType
+		move     string
+		valid    bool
+	}{
+		{name: "janggi", gameType: JANGGI, move: "b1c3", valid: true},
+		{name: "janggi rank 10", gameType: JANGGI, move: "e9e10", valid: true},
+		{name: "janggi usi", gameType: JANGGI, move: "7g7f", valid: false},
+		{name: "shogi", gameType: SHOGI, move: "7g7f", valid: true},
+		{name: "shogi promotion", gameType: SHOGI, move: "8h2b+", valid: true},
+		{name: "shogi drop", gameType: SHOGI, move: "P*5e", valid: true},
+		{name: "shogi janggi", gameType: SHOGI, move: "b1c3", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			variant, err := GetVariant(tt.gameType)
+			assert.NoError(t, err)
+
+			move, err := variant.ParseMove(tt.move)
+			if !tt.valid {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.move, move.String())
+		})
+	}
+}
+
+func TestReplay(t *testing.T) {
+	variant, err := GetVariant(SHOGI)
+	assert.NoError(t, err)
+
+	pos, err := replay(variant, []Move{
+		{Move: "7g7f"},
+		{Move: "3c3d"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, PLAYER_ONE, pos.Turn())
+	assert.False(t, pos.Outcome().Ended())
+
+	_, err = replay(variant, []Move{
+		{Move: "7g7f"},
+		{Move: "7g7f"},
+	})
+	assert.Error(t, err)
+}
+
+func TestGameBefore(t *testing.T) {
+	variant, err := GetVariant(SHOGI)
+	assert.NoError(t, err)
+
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	pos, err := replay(variant, []Move{{Move: "7g7f"}})
+	assert.NoError(t, err)
+
+	game := &Game{
+		Type: SHOGI,
+		Moves: []MoveResponse{
+			{Move: "7g7f", Timestamp: start},
+			{Move: "3c3d", Timestamp: start.Add(time.Second)},
+		},
+		Position: "played",
+	}
+
+	before, err := game.Before(start.Add(time.Second))
+	assert.NoError(t, err)
+	assert.Len(t, before.Moves, 1)
+	assert.Equal(t, pos.String(), before.Position)
+	assert.Len(t, game.Moves, 2)
+
+	// nothing was played since
+	before, err = game.Before(start.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Same(t, game, before)
+}
@@ -0,0 +1,107 @@
+package janggi
+
+import "fmt"
+
+// REPETITIONS is the number of times a position has to occur
+// for the game to be drawn
+const REPETITIONS = 3
+
+type Result int
+
+const (
+	ONGOING Result = iota
+	CHECKMATE
+	// STALEMATE is when neither side has a move, as a single
+	// side without one passes instead, see Position.MustPass
+	STALEMATE
+	BIKJANG
+	REPETITION
+)
+
+// Outcome is the state of a game after the last move.
+//
+// Winner is only meaningful for CHECKMATE, every other finished result is a draw.
+type Outcome struct {
+	Result Result
+	Winner Color
+}
+
+func (o Outcome) Ended() bool {
+	return o.Result != ONGOING
+}
+
+func (o Outcome) Draw() bool {
+	return o.Ended() && o.Result != CHECKMATE
+}
+
+// Game is a position along with the history needed to tell
+// repetitions and bikjang apart from a single position
+type Game struct {
+	pos *Position
+
+	// counts how many times each position has occurred
+	seen map[string]int
+
+	// bikjang that the side to move has to break
+	bikjang bool
+	outcome Outcome
+}
+
+func NewGame() *Game {
+	pos := NewPosition()
+	return &Game{
+		pos: pos,
+		seen: map[string]int{
+			pos.Key(): 1,
+		},
+	}
+}
+
+func (g *Game) Position() *Position {
+	return g.pos
+}
+
+func (g *Game) Outcome() Outcome {
+	return g.outcome
+}
+
+// Play makes the move if it is legal and the game has not ended,
+// then works out the outcome of the new position
+func (g *Game) Play(m Move) error {
+	if g.outcome.Ended() {
+		return fmt.Errorf("game has already ended")
+	}
+
+	mover := g.pos.Turn()
+	err := g.pos.Play(m)
+	if err != nil {
+		return err
+	}
+
+	key := g.pos.Key()
+	g.seen[key]++
+
+	// a side left without a move that is not in check passes instead,
+	// so the game goes on unless neither side can move
+	if len(g.pos.LegalMoves()) == 0 {
+		if g.pos.InCheck(g.pos.Turn()) {
+			g.outcome = Outcome{Result: CHECKMATE, Winner: mover}
+		} else if g.pos.apply(g.pos.PassMove()).MustPass() {
+			g.outcome = Outcome{Result: STALEMATE}
+		}
+	}
+
+	bikjang := g.pos.Bikjang()
+	if !g.outcome.Ended() {
+		switch {
+		// the move left the generals facing after the opponent had declared bikjang
+		case bikjang && g.bikjang:
+			g.outcome = Outcome{Result: BIKJANG}
+		case g.seen[key] >= REPETITIONS:
+			g.outcome = Outcome{Result: REPETITION}
+		}
+	}
+	g.bikjang = bikjang
+
+	return nil
+}
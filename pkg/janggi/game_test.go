@@ -0,0 +1,110 @@
+package janggi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func play(t *testing.T, g *Game, moves ...string) {
+	for _, str := range moves {
+		m, err := ParseMove(str)
+		assert.NoError(t, err)
+		assert.NoError(t, g.Play(m), str)
+	}
+}
+
+func TestCheckmate(t *testing.T) {
+	g := &Game{
+		pos: newEmptyPosition(RED, map[string]Piece{
+			"e1":  {Kind: GENERAL, Color: RED},
+			"d10": {Kind: GENERAL, Color: BLUE},
+			"a9":  {Kind: CHARIOT, Color: RED},
+			"b1":  {Kind: CHARIOT, Color: RED},
+		}),
+		seen: map[string]int{},
+	}
+
+	play(t, g, "b1b10")
+	assert.Equal(t, Outcome{Result: CHECKMATE, Winner: RED}, g.Outcome())
+	assert.False(t, g.Outcome().Draw())
+
+	m, _ := ParseMove("d10e10")
+	assert.Error(t, g.Play(m), "game is over")
+}
+
+func TestBikjangDraw(t *testing.T) {
+	g := &Game{
+		pos: newEmptyPosition(RED, map[string]Piece{
+			"e2": {Kind: GENERAL, Color: RED},
+			"e9": {Kind: GENERAL, Color: BLUE},
+			"e5": {Kind: SOLDIER, Color: RED},
+			"a7": {Kind: SOLDIER, Color: BLUE},
+		}),
+		seen: map[string]int{},
+	}
+
+	play(t, g, "e5d5")
+	assert.False(t, g.Outcome().Ended(), "bikjang was only declared")
+
+	play(t, g, "a7a6")
+	assert.Equal(t, BIKJANG, g.Outcome().Result)
+	assert.True(t, g.Outcome().Draw())
+}
+
+func TestBikjangForcedPass(t *testing.T) {
+	g := &Game{
+		pos: newEmptyPosition(RED, map[string]Piece{
+			"e1":  {Kind: GENERAL, Color: RED},
+			"e10": {Kind: GENERAL, Color: BLUE},
+			"a9":  {Kind: CHARIOT, Color: RED},
+			"d1":  {Kind: CHARIOT, Color: RED},
+			"i2":  {Kind: CHARIOT, Color: RED},
+		}),
+		seen: map[string]int{},
+		// blue declared bikjang
+		bikjang: true,
+	}
+
+	// blue is left to pass, but red kept the generals facing
+	play(t, g, "i2f2")
+	assert.True(t, g.Position().MustPass())
+	assert.Equal(t, BIKJANG, g.Outcome().Result)
+}
+
+func TestRepetition(t *testing.T) {
+	g := NewGame()
+
+	play(t, g, "b1a3", "b10a8", "a3b1", "a8b10")
+	assert.False(t, g.Outcome().Ended())
+
+	play(t, g, "b1a3", "b10a8", "a3b1", "a8b10")
+	assert.Equal(t, REPETITION, g.Outcome().Result)
+}
+
+func TestForcedPass(t *testing.T) {
+	g := &Game{
+		pos: newEmptyPosition(RED, map[string]Piece{
+			"f1":  {Kind: GENERAL, Color: RED},
+			"d10": {Kind: GENERAL, Color: BLUE},
+			"a9":  {Kind: CHARIOT, Color: RED},
+			"e4":  {Kind: CHARIOT, Color: RED},
+		}),
+		seen: map[string]int{},
+	}
+
+	// passing is not allowed while there is a move to make
+	m, _ := ParseMove("f1f1")
+	assert.Error(t, g.Play(m))
+
+	// blue is left without a move, but is not in check
+	play(t, g, "e4e5")
+	assert.False(t, g.Outcome().Ended())
+	assert.True(t, g.Position().MustPass())
+	assert.Equal(t, "d10d10", g.Position().PassMove().String())
+
+	play(t, g, "d10d10")
+	assert.Equal(t, RED, g.Position().Turn())
+	assert.Equal(t, Piece{Kind: GENERAL, Color: BLUE}, g.Position().At(Square{File: 3, Rank: 9}))
+	assert.False(t, g.Outcome().Ended())
+}
@@ -0,0 +1,88 @@
+package janggi
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	FILES = 9
+	RANKS = 10
+)
+
+// Square is a point on the board.
+//
+// File 0 is "a" and rank 0 is "1", so "e2" is Square{File: 4, Rank: 1}.
+type Square struct {
+	File int
+	Rank int
+}
+
+func (s Square) valid() bool {
+	return s.File >= 0 && s.File < FILES && s.Rank >= 0 && s.Rank < RANKS
+}
+
+func (s Square) add(file, rank int) Square {
+	return Square{File: s.File + file, Rank: s.Rank + rank}
+}
+
+func (s Square) index() int {
+	return s.Rank*FILES + s.File
+}
+
+func (s Square) String() string {
+	return fmt.Sprintf("%c%d", 'a'+s.File, s.Rank+1)
+}
+
+// ParseSquare parses a square such as "a1" or "i10"
+func ParseSquare(str string) (Square, error) {
+	if len(str) < 2 || len(str) > 3 {
+		return Square{}, fmt.Errorf("invalid square: %s", str)
+	}
+
+	rank, err := strconv.Atoi(str[1:])
+	if err != nil {
+		return Square{}, fmt.Errorf("invalid square: %s", str)
+	}
+
+	sq := Square{File: int(str[0] - 'a'), Rank: rank - 1}
+	if !sq.valid() {
+		return Square{}, fmt.Errorf("invalid square: %s", str)
+	}
+
+	return sq, nil
+}
+
+type Move struct {
+	From Square
+	To   Square
+}
+
+func (m Move) String() string {
+	return m.From.String() + m.To.String()
+}
+
+// ParseMove parses a move written as two squares, i.e. "b1c3" or "e4e10"
+func ParseMove(str string) (Move, error) {
+	// the first square is either 2 or 3 characters long,
+	// which can be told apart by whether the third character is a file
+	split := 2
+	if len(str) > 2 && str[2] >= '0' && str[2] <= '9' {
+		split = 3
+	}
+	if len(str) <= split {
+		return Move{}, fmt.Errorf("invalid move: %s", str)
+	}
+
+	from, err := ParseSquare(str[:split])
+	if err != nil {
+		return Move{}, fmt.Errorf("invalid move: %s", str)
+	}
+
+	to, err := ParseSquare(str[split:])
+	if err != nil {
+		return Move{}, fmt.Errorf("invalid move: %s", str)
+	}
+
+	return Move{From: from, To: to}, nil
+}
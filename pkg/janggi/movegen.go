@@ -0,0 +1,250 @@
+package janggi
+
+var (
+	orthogonals = [4][2]int{{0, 1}, {0, -1}, {1, 0}, {-1, 0}}
+	diagonals   = [4][2]int{{1, 1}, {1, -1}, {-1, 1}, {-1, -1}}
+)
+
+// inPalace returns true if sq is inside either palace
+func inPalace(sq Square) bool {
+	return sq.valid() && sq.File >= 3 && sq.File <= 5 &&
+		(sq.Rank <= 2 || sq.Rank >= 7)
+}
+
+// inOwnPalace returns true if sq is inside the palace of the given color
+func inOwnPalace(sq Square, color Color) bool {
+	if !sq.valid() {
+		return false
+	}
+	if color == RED {
+		return sq.File >= 3 && sq.File <= 5 && sq.Rank <= 2
+	}
+	return sq.File >= 3 && sq.File <= 5 && sq.Rank >= 7
+}
+
+func isPalaceCenter(sq Square) bool {
+	return sq.File == 4 && (sq.Rank == 1 || sq.Rank == 8)
+}
+
+func isPalaceCorner(sq Square) bool {
+	return (sq.File == 3 || sq.File == 5) &&
+		(sq.Rank == 0 || sq.Rank == 2 || sq.Rank == 7 || sq.Rank == 9)
+}
+
+// onPalaceDiagonal returns true if a one step diagonal move from sq to
+// the target follows one of the lines drawn across the palaces
+func onPalaceDiagonal(sq, target Square) bool {
+	if !inPalace(sq) || !inPalace(target) {
+		return false
+	}
+	return isPalaceCenter(sq) && isPalaceCorner(target) ||
+		isPalaceCorner(sq) && isPalaceCenter(target)
+}
+
+func (p *Position) pseudoLegalMoves(color Color) []Move {
+	moves := make([]Move, 0)
+	for i, piece := range p.board {
+		if piece.Empty() || piece.Color != color {
+			continue
+		}
+		moves = append(moves, p.pieceMoves(Square{File: i % FILES, Rank: i / FILES})...)
+	}
+	return moves
+}
+
+// pieceMoves returns the moves of the piece on sq, ignoring checks
+func (p *Position) pieceMoves(sq Square) []Move {
+	piece := p.At(sq)
+
+	var targets []Square
+	switch piece.Kind {
+	case GENERAL, ADVISOR:
+		targets = p.palaceMoves(sq, piece.Color)
+	case HORSE:
+		targets = p.leaperMoves(sq, 1)
+	case ELEPHANT:
+		targets = p.leaperMoves(sq, 2)
+	case CHARIOT:
+		targets = p.chariotMoves(sq)
+	case CANNON:
+		targets = p.cannonMoves(sq)
+	case SOLDIER:
+		targets = p.soldierMoves(sq, piece.Color)
+	}
+
+	moves := make([]Move, 0, len(targets))
+	for _, target := range targets {
+		occupant := p.At(target)
+		if !occupant.Empty() && occupant.Color == piece.Color {
+			continue
+		}
+		moves = append(moves, Move{From: sq, To: target})
+	}
+	return moves
+}
+
+// palaceMoves covers generals and advisors, which take a single step
+// along the lines of their own palace
+func (p *Position) palaceMoves(sq Square, color Color) []Square {
+	targets := make([]Square, 0)
+	for _, d := range orthogonals {
+		target := sq.add(d[0], d[1])
+		if inOwnPalace(target, color) {
+			targets = append(targets, target)
+		}
+	}
+	for _, d := range diagonals {
+		target := sq.add(d[0], d[1])
+		if inOwnPalace(target, color) && onPalaceDiagonal(sq, target) {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+// leaperMoves covers horses (diagonals = 1) and elephants (diagonals = 2).
+//
+// Both take one orthogonal step followed by diagonal steps outward,
+// and are blocked by any piece on the way.
+func (p *Position) leaperMoves(sq Square, diagonalSteps int) []Square {
+	targets := make([]Square, 0)
+	for _, d := range orthogonals {
+		first := sq.add(d[0], d[1])
+		if !first.valid() || !p.At(first).Empty() {
+			continue
+		}
+
+		for _, side := range [2]int{1, -1} {
+			// the diagonal goes forward in the direction of d and to one side of it
+			df, dr := d[0], d[1]
+			if df == 0 {
+				df = side
+			} else {
+				dr = side
+			}
+
+			target := first
+			blocked := false
+			for step := 0; step < diagonalSteps; step++ {
+				target = target.add(df, dr)
+				if !target.valid() {
+					blocked = true
+					break
+				}
+				if step < diagonalSteps-1 && !p.At(target).Empty() {
+					blocked = true
+					break
+				}
+			}
+			if !blocked {
+				targets = append(targets, target)
+			}
+		}
+	}
+	return targets
+}
+
+func (p *Position) chariotMoves(sq Square) []Square {
+	targets := make([]Square, 0)
+	for _, d := range orthogonals {
+		for target := sq.add(d[0], d[1]); target.valid(); target = target.add(d[0], d[1]) {
+			targets = append(targets, target)
+			if !p.At(target).Empty() {
+				break
+			}
+		}
+	}
+
+	// chariots may also slide along the palace diagonals
+	for _, d := range diagonals {
+		from := sq
+		for target := sq.add(d[0], d[1]); onPalaceDiagonal(from, target); target = target.add(d[0], d[1]) {
+			targets = append(targets, target)
+			if !p.At(target).Empty() {
+				break
+			}
+			from = target
+		}
+	}
+	return targets
+}
+
+// cannonMoves returns the squares reachable by jumping over exactly one
+// screen. Cannons can neither jump over nor capture other cannons.
+func (p *Position) cannonMoves(sq Square) []Square {
+	targets := make([]Square, 0)
+	for _, d := range orthogonals {
+		screened := false
+		for target := sq.add(d[0], d[1]); target.valid(); target = target.add(d[0], d[1]) {
+			occupant := p.At(target)
+			if !screened {
+				if occupant.Empty() {
+					continue
+				}
+				if occupant.Kind == CANNON {
+					break
+				}
+				screened = true
+				continue
+			}
+
+			if occupant.Empty() {
+				targets = append(targets, target)
+				continue
+			}
+			if occupant.Kind != CANNON {
+				targets = append(targets, target)
+			}
+			break
+		}
+	}
+
+	// from a palace corner, the center can act as the screen
+	// for a jump to the opposite corner
+	if isPalaceCorner(sq) {
+		for _, d := range diagonals {
+			center := sq.add(d[0], d[1])
+			if !onPalaceDiagonal(sq, center) {
+				continue
+			}
+
+			screen := p.At(center)
+			target := center.add(d[0], d[1])
+			if screen.Empty() || screen.Kind == CANNON || !target.valid() {
+				continue
+			}
+			if p.At(target).Kind != CANNON {
+				targets = append(targets, target)
+			}
+		}
+	}
+	return targets
+}
+
+func (p *Position) soldierMoves(sq Square, color Color) []Square {
+	forward := 1
+	if color == BLUE {
+		forward = -1
+	}
+
+	targets := make([]Square, 0)
+	for _, target := range []Square{
+		sq.add(0, forward),
+		sq.add(1, 0),
+		sq.add(-1, 0),
+	} {
+		if target.valid() {
+			targets = append(targets, target)
+		}
+	}
+
+	// inside the enemy palace soldiers may also step
+	// forward along the diagonals
+	for _, df := range [2]int{1, -1} {
+		target := sq.add(df, forward)
+		if onPalaceDiagonal(sq, target) {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
@@ -0,0 +1,71 @@
+package janggi
+
+// Color is the side a piece belongs to.
+//
+// NOTE: red always moves first, and sits on ranks 1-4 at the start
+type Color int
+
+const (
+	RED Color = iota
+	BLUE
+)
+
+func (c Color) Other() Color {
+	if c == RED {
+		return BLUE
+	}
+	return RED
+}
+
+func (c Color) String() string {
+	if c == RED {
+		return "red"
+	}
+	return "blue"
+}
+
+type Kind int
+
+const (
+	NONE Kind = iota
+	GENERAL
+	ADVISOR
+	ELEPHANT
+	HORSE
+	CHARIOT
+	CANNON
+	SOLDIER
+)
+
+var kindLetters = map[Kind]byte{
+	GENERAL:  'k',
+	ADVISOR:  'a',
+	ELEPHANT: 'e',
+	HORSE:    'h',
+	CHARIOT:  'r',
+	CANNON:   'c',
+	SOLDIER:  'p',
+}
+
+type Piece struct {
+	Kind  Kind
+	Color Color
+}
+
+func (p Piece) Empty() bool {
+	return p.Kind == NONE
+}
+
+// String returns the letter of the piece, upper case for red and
+// lower case for blue. Empty squares are returned as ".".
+func (p Piece) String() string {
+	if p.Empty() {
+		return "."
+	}
+
+	letter := kindLetters[p.Kind]
+	if p.Color == RED {
+		letter -= 'a' - 'A'
+	}
+	return string(letter)
+}
@@ -0,0 +1,227 @@
+package janggi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Position is a janggi board along with the side to move
+type Position struct {
+	board [FILES * RANKS]Piece
+	turn  Color
+}
+
+// back rank from file a to i, mirrored for both sides
+var backRank = [FILES]Kind{
+	CHARIOT, HORSE, ELEPHANT, ADVISOR, NONE, ADVISOR, ELEPHANT, HORSE, CHARIOT,
+}
+
+// NewPosition returns the starting position with red to move
+func NewPosition() *Position {
+	p := &Position{turn: RED}
+
+	for color, ranks := range map[Color][4]int{
+		// back rank, general, cannons, soldiers
+		RED:  {0, 1, 2, 3},
+		BLUE: {9, 8, 7, 6},
+	} {
+		for file, kind := range backRank {
+			p.set(Square{File: file, Rank: ranks[0]}, Piece{Kind: kind, Color: color})
+		}
+		p.set(Square{File: 4, Rank: ranks[1]}, Piece{Kind: GENERAL, Color: color})
+		p.set(Square{File: 1, Rank: ranks[2]}, Piece{Kind: CANNON, Color: color})
+		p.set(Square{File: 7, Rank: ranks[2]}, Piece{Kind: CANNON, Color: color})
+		for file := 0; file < FILES; file += 2 {
+			p.set(Square{File: file, Rank: ranks[3]}, Piece{Kind: SOLDIER, Color: color})
+		}
+	}
+
+	return p
+}
+
+func (p *Position) Turn() Color {
+	return p.turn
+}
+
+// At returns the piece on the given square
+func (p *Position) At(sq Square) Piece {
+	return p.board[sq.index()]
+}
+
+func (p *Position) set(sq Square, piece Piece) {
+	p.board[sq.index()] = piece
+}
+
+func (p *Position) clone() *Position {
+	c := *p
+	return &c
+}
+
+// Key identifies the position for repetition checks
+func (p *Position) Key() string {
+	var b strings.Builder
+	for _, piece := range p.board {
+		b.WriteString(piece.String())
+	}
+	b.WriteString(p.turn.String())
+	return b.String()
+}
+
+// String returns the board with rank 10 on top
+func (p *Position) String() string {
+	var b strings.Builder
+	for rank := RANKS - 1; rank >= 0; rank-- {
+		for file := 0; file < FILES; file++ {
+			b.WriteString(p.At(Square{File: file, Rank: rank}).String())
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func (p *Position) general(color Color) (Square, bool) {
+	for i, piece := range p.board {
+		if piece.Kind == GENERAL && piece.Color == color {
+			return Square{File: i % FILES, Rank: i / FILES}, true
+		}
+	}
+	return Square{}, false
+}
+
+// InCheck returns true if the general of the given color is attacked
+func (p *Position) InCheck(color Color) bool {
+	sq, ok := p.general(color)
+	if !ok {
+		return true
+	}
+
+	for _, m := range p.pseudoLegalMoves(color.Other()) {
+		if m.To == sq {
+			return true
+		}
+	}
+	return false
+}
+
+// Bikjang returns true if both generals face each other on the same file
+// with nothing in between
+func (p *Position) Bikjang() bool {
+	red, ok := p.general(RED)
+	if !ok {
+		return false
+	}
+	blue, ok := p.general(BLUE)
+	if !ok || red.File != blue.File {
+		return false
+	}
+
+	for rank := red.Rank + 1; rank < blue.Rank; rank++ {
+		if !p.At(Square{File: red.File, Rank: rank}).Empty() {
+			return false
+		}
+	}
+	return true
+}
+
+// LegalMoves returns every move the side to move can make
+// without leaving its own general in check
+func (p *Position) LegalMoves() []Move {
+	moves := make([]Move, 0)
+	for _, m := range p.pseudoLegalMoves(p.turn) {
+		if !p.apply(m).InCheck(p.turn) {
+			moves = append(moves, m)
+		}
+	}
+	return moves
+}
+
+// PassMove returns the move the side to move passes with,
+// which is written as its general staying on its square
+func (p *Position) PassMove() Move {
+	sq, _ := p.general(p.turn)
+	return Move{From: sq, To: sq}
+}
+
+// MustPass returns true if the side to move has no legal move
+// while not in check, which leaves it no choice but to pass
+func (p *Position) MustPass() bool {
+	return !p.InCheck(p.turn) && len(p.LegalMoves()) == 0
+}
+
+// IsLegal checks if the side to move is allowed to play m,
+// where passing is only allowed when it is forced
+func (p *Position) IsLegal(m Move) bool {
+	if !m.From.valid() || !m.To.valid() {
+		return false
+	}
+
+	if m.From == m.To {
+		return m == p.PassMove() && p.MustPass()
+	}
+
+	piece := p.At(m.From)
+	if piece.Empty() || piece.Color != p.turn {
+		return false
+	}
+
+	for _, pm := range p.pieceMoves(m.From) {
+		if pm == m {
+			return !p.apply(m).InCheck(p.turn)
+		}
+	}
+	return false
+}
+
+// Play makes the move if it is legal
+func (p *Position) Play(m Move) error {
+	if !p.IsLegal(m) {
+		return fmt.Errorf("illegal move for %s: %s", p.turn, m)
+	}
+
+	*p = *p.apply(m)
+	return nil
+}
+
+// apply returns a copy of the position with m played,
+// whether or not it was legal
+func (p *Position) apply(m Move) *Position {
+	next := p.clone()
+	if m.From != m.To {
+		next.set(m.To, next.At(m.From))
+		next.set(m.From, Piece{})
+	}
+	next.turn = p.turn.Other()
+	return next
+}
+
+// FEN returns the position in a FEN-like notation, ranks from 10 to 1
+// separated by "/" followed by the side to move ("r" or "b")
+func (p *Position) FEN() string {
+	var b strings.Builder
+	for rank := RANKS - 1; rank >= 0; rank-- {
+		empty := 0
+		for file := 0; file < FILES; file++ {
+			piece := p.At(Square{File: file, Rank: rank})
+			if piece.Empty() {
+				empty++
+				continue
+			}
+			if empty > 0 {
+				b.WriteString(strconv.Itoa(empty))
+				empty = 0
+			}
+			b.WriteString(piece.String())
+		}
+		if empty > 0 {
+			b.WriteString(strconv.Itoa(empty))
+		}
+		if rank > 0 {
+			b.WriteByte('/')
+		}
+	}
+
+	b.WriteByte(' ')
+	b.WriteByte(p.turn.String()[0])
+	return b.String()
+}
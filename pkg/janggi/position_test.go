@@ -0,0 +1,214 @@
+package janggi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newEmptyPosition returns a board with only the given pieces on it
+func newEmptyPosition(turn Color, pieces map[string]Piece) *Position {
+	p := &Position{turn: turn}
+	for str, piece := range pieces {
+		sq, err := ParseSquare(str)
+		if err != nil {
+			panic(err)
+		}
+		p.set(sq, piece)
+	}
+	return p
+}
+
+func targets(p *Position, str string) []string {
+	sq, err := ParseSquare(str)
+	if err != nil {
+		panic(err)
+	}
+
+	toRet := make([]string, 0)
+	for _, m := range p.LegalMoves() {
+		if m.From == sq {
+			toRet = append(toRet, m.To.String())
+		}
+	}
+	return toRet
+}
+
+func TestParseMove(t *testing.T) {
+	tests := []struct {
+		name  string
+		move  string
+		valid bool
+	}{
+		{name: "short", move: "b1c3", valid: true},
+		{name: "to rank 10", move: "e9e10", valid: true},
+		{name: "from rank 10", move: "a10a9", valid: true},
+		{name: "both rank 10", move: "a10i10", valid: true},
+		{name: "off board file", move: "j1j2", valid: false},
+		{name: "off board rank", move: "a11a10", valid: false},
+		{name: "incomplete", move: "a1", valid: false},
+		{name: "empty", move: "", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := ParseMove(tt.move)
+			if !tt.valid {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.move, m.String())
+		})
+	}
+}
+
+func TestInitialPosition(t *testing.T) {
+	p := NewPosition()
+
+	assert.Equal(t, RED, p.Turn())
+	assert.Len(t, p.LegalMoves(), 31)
+	assert.False(t, p.InCheck(RED))
+	assert.False(t, p.InCheck(BLUE))
+	assert.False(t, p.Bikjang())
+}
+
+func TestCannon(t *testing.T) {
+	p := newEmptyPosition(RED, map[string]Piece{
+		"e2": {Kind: GENERAL, Color: RED},
+		"d9": {Kind: GENERAL, Color: BLUE},
+		"a1": {Kind: CANNON, Color: RED},
+		"a3": {Kind: SOLDIER, Color: RED},
+		"a6": {Kind: HORSE, Color: BLUE},
+		"c1": {Kind: CANNON, Color: BLUE},
+	})
+
+	// jumps a3, captures the horse, cannot screen with or capture the cannon
+	assert.ElementsMatch(t, []string{"a4", "a5", "a6"}, targets(p, "a1"))
+}
+
+func TestCannonPalaceDiagonal(t *testing.T) {
+	p := newEmptyPosition(RED, map[string]Piece{
+		"e1":  {Kind: GENERAL, Color: RED},
+		"f10": {Kind: GENERAL, Color: BLUE},
+		"d8":  {Kind: CANNON, Color: RED},
+		"e9":  {Kind: ADVISOR, Color: BLUE},
+	})
+
+	assert.Contains(t, targets(p, "d8"), "f10")
+}
+
+func TestHorseBlocked(t *testing.T) {
+	p := newEmptyPosition(RED, map[string]Piece{
+		"e2": {Kind: GENERAL, Color: RED},
+		"d9": {Kind: GENERAL, Color: BLUE},
+		"e5": {Kind: HORSE, Color: RED},
+		"e6": {Kind: SOLDIER, Color: BLUE},
+	})
+
+	assert.ElementsMatch(t,
+		[]string{"c4", "c6", "g4", "g6", "d3", "f3"},
+		targets(p, "e5"),
+	)
+}
+
+func TestElephantBlocked(t *testing.T) {
+	p := newEmptyPosition(RED, map[string]Piece{
+		"e2": {Kind: GENERAL, Color: RED},
+		"d9": {Kind: GENERAL, Color: BLUE},
+		"e5": {Kind: ELEPHANT, Color: RED},
+		"f7": {Kind: SOLDIER, Color: BLUE},
+	})
+
+	// f7 blocks the jump to g8 but not to c8
+	moves := targets(p, "e5")
+	assert.NotContains(t, moves, "g8")
+	assert.Contains(t, moves, "c8")
+	assert.Len(t, moves, 7)
+}
+
+func TestChariotPalaceDiagonal(t *testing.T) {
+	p := newEmptyPosition(RED, map[string]Piece{
+		"e2":  {Kind: GENERAL, Color: RED},
+		"e10": {Kind: GENERAL, Color: BLUE},
+		"d8":  {Kind: CHARIOT, Color: RED},
+		"e5":  {Kind: SOLDIER, Color: RED},
+	})
+
+	moves := targets(p, "d8")
+	assert.Contains(t, moves, "e9")
+	assert.Contains(t, moves, "f10")
+}
+
+func TestGeneralConfinedToPalace(t *testing.T) {
+	p := newEmptyPosition(RED, map[string]Piece{
+		"d1":  {Kind: GENERAL, Color: RED},
+		"f10": {Kind: GENERAL, Color: BLUE},
+	})
+
+	assert.ElementsMatch(t, []string{"d2", "e1", "e2"}, targets(p, "d1"))
+}
+
+func TestSoldierPalaceDiagonal(t *testing.T) {
+	p := newEmptyPosition(RED, map[string]Piece{
+		"d1":  {Kind: GENERAL, Color: RED},
+		"f10": {Kind: GENERAL, Color: BLUE},
+		"e9":  {Kind: SOLDIER, Color: RED},
+	})
+
+	assert.ElementsMatch(t, []string{"e10", "d9", "f9", "d10", "f10"}, targets(p, "e9"))
+}
+
+func TestCannotIgnoreCheck(t *testing.T) {
+	p := newEmptyPosition(RED, map[string]Piece{
+		"e2":  {Kind: GENERAL, Color: RED},
+		"d10": {Kind: GENERAL, Color: BLUE},
+		"e8":  {Kind: CHARIOT, Color: BLUE},
+		"a1":  {Kind: CHARIOT, Color: RED},
+	})
+
+	assert.True(t, p.InCheck(RED))
+	for _, m := range p.LegalMoves() {
+		assert.Equal(t, "e2", m.From.String(), "only the general can escape")
+	}
+
+	m, _ := ParseMove("a1a2")
+	assert.Error(t, p.Play(m))
+}
+
+func TestBikjang(t *testing.T) {
+	p := newEmptyPosition(RED, map[string]Piece{
+		"e2": {Kind: GENERAL, Color: RED},
+		"e9": {Kind: GENERAL, Color: BLUE},
+		"e5": {Kind: SOLDIER, Color: RED},
+	})
+	assert.False(t, p.Bikjang())
+
+	// moving the screen away is legal and faces the generals
+	m, _ := ParseMove("e5d5")
+	assert.NoError(t, p.Play(m))
+	assert.True(t, p.Bikjang())
+}
+
+func TestPlay(t *testing.T) {
+	p := NewPosition()
+
+	for _, str := range []string{"c4c5", "c7c6", "c5c6"} {
+		m, err := ParseMove(str)
+		assert.NoError(t, err)
+		assert.NoError(t, p.Play(m), str)
+	}
+
+	sq, _ := ParseSquare("c6")
+	assert.Equal(t, Piece{Kind: SOLDIER, Color: RED}, p.At(sq))
+	assert.Equal(t, BLUE, p.Turn())
+
+	m, _ := ParseMove("a1a2")
+	assert.Error(t, p.Play(m), "not red's turn")
+}
+
+func TestFEN(t *testing.T) {
+	p := NewPosition()
+	assert.Equal(t, "rhea1aehr/4k4/1c5c1/p1p1p1p1p/9/9/P1P1P1P1P/1C5C1/4K4/RHEA1AEHR r", p.FEN())
+}
@@ -0,0 +1,62 @@
+package matchmaking
+
+import (
+	"context"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/garlicgarrison/chessvars-backend/pkg/game"
+)
+
+type Service interface {
+	Enter(context.Context, EnterRequest) (*EnterResponse, error)
+	Leave(context.Context, LeaveRequest) (*LeaveResponse, error)
+
+	// Match pairs the waiting players that are close enough in rating,
+	// meant to be run periodically in the background
+	Match(context.Context, MatchRequest) (*MatchResponse, error)
+
+	// Matches streams the games found for the user until ctx is done
+	Matches(context.Context, MatchesRequest) (<-chan *Match, error)
+}
+
+type Ticket struct {
+	UserID    format.UserID  `json:"user_id"`
+	Type      game.GameType  `json:"type"`
+	TimeLimit game.TimeLimit `json:"time_limit"`
+	Elo       int            `json:"elo"`
+	Timestamp time.Time      `json:"timestamp"`
+}
+
+type Match struct {
+	GameID     format.GameID `json:"game_id"`
+	UserID     format.UserID `json:"user_id"`
+	OpponentID format.UserID `json:"opponent_id"`
+}
+
+type EnterRequest struct {
+	UserID    format.UserID  `json:"user_id"`
+	Type      game.GameType  `json:"type"`
+	TimeLimit game.TimeLimit `json:"time_limit"`
+}
+
+type EnterResponse = Ticket
+
+type LeaveRequest struct {
+	UserID format.UserID `json:"user_id"`
+}
+
+type LeaveResponse struct {
+	// Left is false if the user was not in the queue
+	Left bool `json:"left"`
+}
+
+type MatchRequest struct{}
+
+type MatchResponse struct {
+	Matches []*Match `json:"matches"`
+}
+
+type MatchesRequest struct {
+	UserID format.UserID `json:"user_id"`
+}
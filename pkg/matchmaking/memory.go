@@ -0,0 +1,128 @@
+package matchmaking
+
+import (
+	"context"
+	"sync"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+)
+
+// memoryQueue is a Queue for a single instance
+type memoryQueue struct {
+	mu          sync.Mutex
+	tickets     map[format.UserID]Ticket
+	subscribers map[format.UserID][]chan *Match
+}
+
+func NewMemoryQueue() Queue {
+	return &memoryQueue{
+		tickets:     make(map[format.UserID]Ticket),
+		subscribers: make(map[format.UserID][]chan *Match),
+	}
+}
+
+func (q *memoryQueue) Add(_ context.Context, ticket Ticket) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.tickets[ticket.UserID] = ticket
+	return nil
+}
+
+func (q *memoryQueue) Remove(_ context.Context, userID format.UserID) (bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	_, ok := q.tickets[userID]
+	delete(q.tickets, userID)
+	return ok, nil
+}
+
+func (q *memoryQueue) Take(_ context.Context, a, b Ticket) (bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, ticket := range []Ticket{a, b} {
+		current, ok := q.tickets[ticket.UserID]
+		if !ok || !same(current, ticket) {
+			return false, nil
+		}
+	}
+
+	delete(q.tickets, a.UserID)
+	delete(q.tickets, b.UserID)
+	return true, nil
+}
+
+func (q *memoryQueue) Pools(_ context.Context) ([]Pool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	seen := make(map[Pool]bool)
+	pools := make([]Pool, 0)
+	for _, ticket := range q.tickets {
+		if !seen[ticket.Pool()] {
+			seen[ticket.Pool()] = true
+			pools = append(pools, ticket.Pool())
+		}
+	}
+	return pools, nil
+}
+
+func (q *memoryQueue) Tickets(_ context.Context, pool Pool) ([]Ticket, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	tickets := make([]Ticket, 0)
+	for _, ticket := range q.tickets {
+		if ticket.Pool() == pool {
+			tickets = append(tickets, ticket)
+		}
+	}
+	return tickets, nil
+}
+
+func (q *memoryQueue) Publish(_ context.Context, match *Match) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, c := range q.subscribers[match.UserID] {
+		// a subscriber that is not reading does not hold up the others
+		select {
+		case c <- match:
+		default:
+		}
+	}
+	return nil
+}
+
+func (q *memoryQueue) Subscribe(ctx context.Context, userID format.UserID) (<-chan *Match, error) {
+	c := make(chan *Match, 1)
+
+	q.mu.Lock()
+	q.subscribers[userID] = append(q.subscribers[userID], c)
+	q.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		q.mu.Lock()
+		defer q.mu.Unlock()
+
+		subscribers := q.subscribers[userID]
+		for i, sub := range subscribers {
+			if sub == c {
+				subscribers = append(subscribers[:i], subscribers[i+1:]...)
+				break
+			}
+		}
+		if len(subscribers) == 0 {
+			delete(q.subscribers, userID)
+		} else {
+			q.subscribers[userID] = subscribers
+		}
+		close(c)
+	}()
+
+	return c, nil
+}
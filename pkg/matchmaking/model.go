@@ -0,0 +1,46 @@
+package matchmaking
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// RATING_WINDOW is the largest rating difference of a pairing
+	// for a player that just entered the queue
+	RATING_WINDOW = 100
+	// RATING_WINDOW_STEP is how much the window widens
+	// for every RATING_WINDOW_INTERVAL spent waiting
+	RATING_WINDOW_STEP     = 50
+	RATING_WINDOW_INTERVAL = 10 * time.Second
+	MAX_RATING_WINDOW      = 800
+)
+
+// Pool is the set of tickets that can be paired with each other,
+// which is every ticket of the same game type and time limit
+type Pool string
+
+func (p Pool) String() string {
+	return string(p)
+}
+
+func (t Ticket) Pool() Pool {
+	return Pool(fmt.Sprintf("%s:%d", t.Type, t.TimeLimit))
+}
+
+// window is the largest rating difference the ticket accepts at now
+func (t Ticket) window(now time.Time) int {
+	window := RATING_WINDOW + RATING_WINDOW_STEP*int(now.Sub(t.Timestamp)/RATING_WINDOW_INTERVAL)
+	if window > MAX_RATING_WINDOW {
+		return MAX_RATING_WINDOW
+	}
+	return window
+}
+
+// same checks that b is still the ticket a that was listed,
+// and not one the user entered again with since
+func same(a, b Ticket) bool {
+	return a.UserID == b.UserID &&
+		a.Pool() == b.Pool() &&
+		a.Timestamp.Equal(b.Timestamp)
+}
@@ -0,0 +1,50 @@
+package matchmaking
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+)
+
+// Queue holds the tickets of the waiting players and delivers the
+// matches found for them, possibly across instances
+type Queue interface {
+	// Add puts the ticket in its pool, replacing any ticket of the same user
+	Add(context.Context, Ticket) error
+	// Remove takes the user out of the queue,
+	// returning false if it was not in it
+	Remove(context.Context, format.UserID) (bool, error)
+	// Take removes both tickets at once, returning false without removing
+	// anything if either of them is not in the queue anymore
+	Take(context.Context, Ticket, Ticket) (bool, error)
+
+	Pools(context.Context) ([]Pool, error)
+	Tickets(context.Context, Pool) ([]Ticket, error)
+
+	Publish(context.Context, *Match) error
+	// Subscribe streams the matches of the user until ctx is done
+	Subscribe(context.Context, format.UserID) (<-chan *Match, error)
+}
+
+// pair greedily pairs the tickets of a pool that are next to each other
+// in rating, when both accept the difference at now
+func pair(tickets []Ticket, now time.Time) [][2]Ticket {
+	sorted := append([]Ticket{}, tickets...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Elo < sorted[j].Elo
+	})
+
+	pairs := make([][2]Ticket, 0)
+	for i := 0; i+1 < len(sorted); i++ {
+		a, b := sorted[i], sorted[i+1]
+
+		diff := b.Elo - a.Elo
+		if diff <= a.window(now) && diff <= b.window(now) {
+			pairs = append(pairs, [2]Ticket{a, b})
+			i++
+		}
+	}
+	return pairs
+}
@@ -0,0 +1,132 @@
+package matchmaking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/garlicgarrison/chessvars-backend/pkg/game"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPair(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ticket := func(userID format.UserID, elo int, waited time.Duration) Ticket {
+		return Ticket{
+			UserID:    userID,
+			Type:      game.JANGGI,
+			TimeLimit: game.BLITZ,
+			Elo:       elo,
+			Timestamp: now.Add(-waited),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		tickets []Ticket
+		pairs   [][2]format.UserID
+	}{
+		{
+			name:    "within the window",
+			tickets: []Ticket{ticket("a", 1200, 0), ticket("b", 1250, 0)},
+			pairs:   [][2]format.UserID{{"a", "b"}},
+		},
+		{
+			name:    "outside the window",
+			tickets: []Ticket{ticket("a", 1200, 0), ticket("b", 1400, 0)},
+			pairs:   [][2]format.UserID{},
+		},
+		{
+			name: "window widens for both",
+			tickets: []Ticket{
+				ticket("a", 1200, 20*time.Second),
+				ticket("b", 1400, 20*time.Second),
+			},
+			pairs: [][2]format.UserID{{"a", "b"}},
+		},
+		{
+			name: "window has to fit both",
+			tickets: []Ticket{
+				ticket("a", 1200, time.Minute),
+				ticket("b", 1400, 0),
+			},
+			pairs: [][2]format.UserID{},
+		},
+		{
+			name: "closest ratings",
+			tickets: []Ticket{
+				ticket("d", 1900, 0),
+				ticket("a", 1200, 0),
+				ticket("c", 1850, 0),
+				ticket("b", 1210, 0),
+				ticket("e", 2500, 0),
+			},
+			pairs: [][2]format.UserID{{"a", "b"}, {"c", "d"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pairs := make([][2]format.UserID, 0)
+			for _, p := range pair(tt.tickets, now) {
+				pairs = append(pairs, [2]format.UserID{p[0].UserID, p[1].UserID})
+			}
+			assert.Equal(t, tt.pairs, pairs)
+		})
+	}
+}
+
+func TestMemoryQueue(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	q := NewMemoryQueue()
+	a := Ticket{UserID: "a", Type: game.SHOGI, TimeLimit: game.BLITZ, Timestamp: now}
+	b := Ticket{UserID: "b", Type: game.SHOGI, TimeLimit: game.BLITZ, Timestamp: now}
+	assert.NoError(t, q.Add(ctx, a))
+	assert.NoError(t, q.Add(ctx, b))
+
+	pools, err := q.Pools(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []Pool{a.Pool()}, pools)
+
+	// b entered again with another time limit after being listed
+	rejoined := b
+	rejoined.TimeLimit = game.RAPID
+	rejoined.Timestamp = now.Add(time.Second)
+	assert.NoError(t, q.Add(ctx, rejoined))
+
+	ok, err := q.Take(ctx, a, b)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	tickets, err := q.Tickets(ctx, a.Pool())
+	assert.NoError(t, err)
+	assert.Equal(t, []Ticket{a}, tickets)
+
+	ok, err = q.Take(ctx, a, rejoined)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = q.Remove(ctx, "a")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestMemoryQueueSubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	q := NewMemoryQueue()
+	matches, err := q.Subscribe(ctx, "a")
+	assert.NoError(t, err)
+
+	match := &Match{GameID: "game", UserID: "a", OpponentID: "b"}
+	assert.NoError(t, q.Publish(ctx, match))
+	assert.NoError(t, q.Publish(ctx, &Match{GameID: "other", UserID: "b", OpponentID: "a"}))
+	assert.Equal(t, match, <-matches)
+
+	cancel()
+	_, ok := <-matches
+	assert.False(t, ok)
+}
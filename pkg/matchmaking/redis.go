@@ -0,0 +1,250 @@
+package matchmaking
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/go-redis/redis"
+)
+
+const (
+	REDIS_POOLS_KEY      = "matchmaking:pools"
+	REDIS_POOL_PREFIX    = "matchmaking:pool:"
+	REDIS_TICKET_PREFIX  = "matchmaking:ticket:"
+	REDIS_MATCHES_PREFIX = "matchmaking:matches:"
+)
+
+// redisQueue is a Queue shared by every instance.
+//
+// Each ticket is stored as JSON under its own key, and the members of
+// each pool in a set, so that taking two tickets only has to watch
+// the keys of those two tickets.
+type redisQueue struct {
+	client *redis.Client
+}
+
+func NewRedisQueue(client *redis.Client) (Queue, error) {
+	if client == nil {
+		return nil, errors.New("redis client required")
+	}
+
+	return &redisQueue{
+		client: client,
+	}, nil
+}
+
+func ticketKey(userID format.UserID) string {
+	return REDIS_TICKET_PREFIX + userID.String()
+}
+
+func poolKey(pool Pool) string {
+	return REDIS_POOL_PREFIX + pool.String()
+}
+
+func matchesKey(userID format.UserID) string {
+	return REDIS_MATCHES_PREFIX + userID.String()
+}
+
+// getTicket returns nil if the user has no ticket
+func getTicket(cmd redis.Cmdable, userID format.UserID) (*Ticket, error) {
+	data, err := cmd.Get(ticketKey(userID)).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var ticket Ticket
+	err = json.Unmarshal(data, &ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ticket, nil
+}
+
+func (q *redisQueue) Add(_ context.Context, ticket Ticket) error {
+	data, err := json.Marshal(ticket)
+	if err != nil {
+		return err
+	}
+
+	key := ticketKey(ticket.UserID)
+	return q.client.Watch(func(tx *redis.Tx) error {
+		old, err := getTicket(tx, ticket.UserID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Pipelined(func(pipe redis.Pipeliner) error {
+			if old != nil {
+				pipe.SRem(poolKey(old.Pool()), ticket.UserID.String())
+			}
+			pipe.Set(key, data, 0)
+			pipe.SAdd(poolKey(ticket.Pool()), ticket.UserID.String())
+			pipe.SAdd(REDIS_POOLS_KEY, ticket.Pool().String())
+			return nil
+		})
+		return err
+	}, key)
+}
+
+func (q *redisQueue) Remove(_ context.Context, userID format.UserID) (bool, error) {
+	removed := false
+
+	key := ticketKey(userID)
+	err := q.client.Watch(func(tx *redis.Tx) error {
+		ticket, err := getTicket(tx, userID)
+		if err != nil || ticket == nil {
+			return err
+		}
+
+		_, err = tx.Pipelined(func(pipe redis.Pipeliner) error {
+			pipe.Del(key)
+			pipe.SRem(poolKey(ticket.Pool()), userID.String())
+			return nil
+		})
+		removed = err == nil
+		return err
+	}, key)
+
+	return removed, err
+}
+
+func (q *redisQueue) Take(_ context.Context, a, b Ticket) (bool, error) {
+	taken := false
+
+	err := q.client.Watch(func(tx *redis.Tx) error {
+		for _, ticket := range []Ticket{a, b} {
+			current, err := getTicket(tx, ticket.UserID)
+			if err != nil {
+				return err
+			}
+			if current == nil || !same(*current, ticket) {
+				return nil
+			}
+		}
+
+		_, err := tx.Pipelined(func(pipe redis.Pipeliner) error {
+			for _, ticket := range []Ticket{a, b} {
+				pipe.Del(ticketKey(ticket.UserID))
+				pipe.SRem(poolKey(ticket.Pool()), ticket.UserID.String())
+			}
+			return nil
+		})
+		taken = err == nil
+		return err
+	}, ticketKey(a.UserID), ticketKey(b.UserID))
+
+	// someone else changed either ticket first
+	if err == redis.TxFailedErr {
+		return false, nil
+	}
+
+	return taken, err
+}
+
+func (q *redisQueue) Pools(_ context.Context) ([]Pool, error) {
+	members, err := q.client.SMembers(REDIS_POOLS_KEY).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	pools := make([]Pool, 0)
+	for _, member := range members {
+		pools = append(pools, Pool(member))
+	}
+	return pools, nil
+}
+
+func (q *redisQueue) Tickets(_ context.Context, pool Pool) ([]Ticket, error) {
+	members, err := q.client.SMembers(poolKey(pool)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(members) == 0 {
+		return []Ticket{}, nil
+	}
+
+	keys := make([]string, 0)
+	for _, member := range members {
+		keys = append(keys, ticketKey(format.UserID(member)))
+	}
+
+	values, err := q.client.MGet(keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	tickets := make([]Ticket, 0)
+	for _, value := range values {
+		// the ticket was removed since the pool was read
+		data, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		var ticket Ticket
+		err = json.Unmarshal([]byte(data), &ticket)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ticket: %w", err)
+		}
+		tickets = append(tickets, ticket)
+	}
+	return tickets, nil
+}
+
+func (q *redisQueue) Publish(_ context.Context, match *Match) error {
+	data, err := json.Marshal(match)
+	if err != nil {
+		return err
+	}
+
+	return q.client.Publish(matchesKey(match.UserID), data).Err()
+}
+
+func (q *redisQueue) Subscribe(ctx context.Context, userID format.UserID) (<-chan *Match, error) {
+	pubsub := q.client.Subscribe(matchesKey(userID))
+
+	// wait for the subscription so that no match published after
+	// this returns is missed
+	_, err := pubsub.Receive()
+	if err != nil {
+		pubsub.Close()
+		return nil, err
+	}
+
+	c := make(chan *Match, 1)
+	go func() {
+		defer close(c)
+		defer pubsub.Close()
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				var match Match
+				if json.Unmarshal([]byte(msg.Payload), &match) != nil {
+					continue
+				}
+
+				select {
+				case c <- &match:
+				default:
+				}
+			}
+		}
+	}()
+
+	return c, nil
+}
@@ -0,0 +1,165 @@
+package matchmaking
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/elo"
+	"github.com/garlicgarrison/chessvars-backend/pkg/game"
+)
+
+type Config struct {
+	Queue Queue
+
+	GameService game.Service
+	EloService  elo.Service
+}
+
+type service struct {
+	queue Queue
+
+	game game.Service
+	elo  elo.Service
+}
+
+func NewService(cfg Config) (Service, error) {
+	if cfg.Queue == nil {
+		return nil, errors.New("queue required")
+	}
+	if cfg.GameService == nil {
+		return nil, errors.New("game service required")
+	}
+	if cfg.EloService == nil {
+		return nil, errors.New("elo service required")
+	}
+
+	return &service{
+		queue: cfg.Queue,
+		game:  cfg.GameService,
+		elo:   cfg.EloService,
+	}, nil
+}
+
+func (s *service) Enter(ctx context.Context, request EnterRequest) (*EnterResponse, error) {
+	_, err := game.GetVariant(request.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	// CreateElo returns the current elo if there is one
+	rating, err := s.elo.CreateElo(ctx, elo.CreateEloRequest{
+		UserID:   request.UserID,
+		Game:     elo.GameType(request.Type),
+		Category: request.TimeLimit.TimeControl().Category(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ticket := Ticket{
+		UserID:    request.UserID,
+		Type:      request.Type,
+		TimeLimit: request.TimeLimit,
+		Elo:       rating.Elo,
+		Timestamp: time.Now(),
+	}
+
+	err = s.queue.Add(ctx, ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ticket, nil
+}
+
+func (s *service) Leave(ctx context.Context, request LeaveRequest) (*LeaveResponse, error) {
+	left, err := s.queue.Remove(ctx, request.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LeaveResponse{
+		Left: left,
+	}, nil
+}
+
+func (s *service) Match(ctx context.Context, request MatchRequest) (*MatchResponse, error) {
+	now := time.Now()
+
+	pools, err := s.queue.Pools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]*Match, 0)
+	for _, pool := range pools {
+		tickets, err := s.queue.Tickets(ctx, pool)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range pair(tickets, now) {
+			match, err := s.start(ctx, p[0], p[1])
+			if err != nil {
+				log.Printf("[Match] -- could not start game for %s and %s: %s", p[0].UserID, p[1].UserID, err)
+				continue
+			}
+			if match != nil {
+				matches = append(matches, match)
+			}
+		}
+	}
+
+	return &MatchResponse{
+		Matches: matches,
+	}, nil
+}
+
+// start takes both tickets out of the queue and creates their game,
+// returning nil if either player was taken or left in the meantime
+func (s *service) start(ctx context.Context, a, b Ticket) (*Match, error) {
+	ok, err := s.queue.Take(ctx, a, b)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	// both seats are filled in the same write, so the game is either
+	// started for both players or not created at all
+	g, err := s.game.CreateGame(ctx, game.CreateGameRequest{
+		UserID:     a.UserID,
+		OpponentID: b.UserID,
+		TimeLimit:  a.TimeLimit,
+		Type:       a.Type,
+	})
+	if err != nil {
+		// put both players back where they were in the queue
+		for _, ticket := range []Ticket{a, b} {
+			if err := s.queue.Add(ctx, ticket); err != nil {
+				log.Printf("[start] -- could not requeue %s: %s", ticket.UserID, err)
+			}
+		}
+		return nil, err
+	}
+
+	for _, match := range []*Match{
+		{GameID: g.ID, UserID: a.UserID, OpponentID: b.UserID},
+		{GameID: g.ID, UserID: b.UserID, OpponentID: a.UserID},
+	} {
+		err = s.queue.Publish(ctx, match)
+		if err != nil {
+			log.Printf("[start] -- could not publish match to %s: %s", match.UserID, err)
+		}
+	}
+
+	return &Match{
+		GameID:     g.ID,
+		UserID:     a.UserID,
+		OpponentID: b.UserID,
+	}, nil
+}
+
+func (s *service) Matches(ctx context.Context, request MatchesRequest) (<-chan *Match, error) {
+	return s.queue.Subscribe(ctx, request.UserID)
+}
@@ -0,0 +1,109 @@
+package presence
+
+import (
+	"context"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+)
+
+type Service interface {
+	// Connect marks the user online until ctx is done, which is meant
+	// to be the context of one of its connections
+	Connect(context.Context, ConnectRequest) error
+	// EnterGame marks the user as in the game until ctx is done, which is
+	// meant to be the context of one of its subscriptions to the game
+	EnterGame(context.Context, EnterGameRequest) (*EnterGameResponse, error)
+	// Search marks the user as waiting for a match until ctx is done, which
+	// is meant to be the context of one of its matchmaking subscriptions
+	Search(context.Context, SearchRequest) (*SearchResponse, error)
+	// GetPresence returns where each of the users is
+	GetPresence(context.Context, GetPresenceRequest) (map[format.UserID]*Presence, error)
+	// GetAway returns since when the user is away from the game, going by
+	// when it was last in it, which still works when its subscriptions
+	// closed without telling, such as the ones of an instance that went down
+	GetAway(context.Context, GetAwayRequest) (*GetAwayResponse, error)
+
+	// Watch counts a spectator of the game until ctx is done, which is
+	// meant to be the context of its subscription to the game
+	Watch(context.Context, WatchRequest) (*WatchResponse, error)
+	// GetViewers returns how many spectators the game has
+	GetViewers(context.Context, GetViewersRequest) (*GetViewersResponse, error)
+}
+
+type Presence struct {
+	Online bool `json:"online"`
+	// Searching is true while the user is waiting for a match
+	Searching bool `json:"searching"`
+	// GameID is the game of the user refreshed last of the ones it is in,
+	// empty when it is in none, and GameIDs all of them
+	GameID  format.GameID   `json:"game_id"`
+	GameIDs []format.GameID `json:"game_ids"`
+}
+
+// InGame returns true if the user is in the game
+func (p *Presence) InGame(gameID format.GameID) bool {
+	for _, id := range p.GameIDs {
+		if id == gameID {
+			return true
+		}
+	}
+	return false
+}
+
+type ConnectRequest struct {
+	UserID format.UserID `json:"user_id"`
+}
+
+type EnterGameRequest struct {
+	UserID format.UserID `json:"user_id"`
+	GameID format.GameID `json:"game_id"`
+}
+
+type EnterGameResponse struct {
+	// Left is closed once ctx is done and the subscription no longer
+	// counts, after which GetPresence tells if the user is still in the game
+	Left <-chan struct{}
+}
+
+type SearchRequest struct {
+	UserID format.UserID `json:"user_id"`
+}
+
+type SearchResponse struct {
+	// Left is closed once ctx is done and the subscription no longer
+	// counts, after which GetPresence tells if the user is still searching
+	Left <-chan struct{}
+}
+
+type GetPresenceRequest struct {
+	UserIDs []format.UserID `json:"user_ids"`
+}
+
+type GetAwayRequest struct {
+	UserID format.UserID `json:"user_id"`
+	GameID format.GameID `json:"game_id"`
+}
+
+type GetAwayResponse struct {
+	// Since is zero while the user is in the game,
+	// or if it has not been in it for SEEN_TTL
+	Since time.Time `json:"since"`
+}
+
+type WatchRequest struct {
+	GameID format.GameID `json:"game_id"`
+}
+
+type WatchResponse struct {
+	// Left is closed once ctx is done and the spectator no longer counts
+	Left <-chan struct{}
+}
+
+type GetViewersRequest struct {
+	GameID format.GameID `json:"game_id"`
+}
+
+type GetViewersResponse struct {
+	Count int `json:"count"`
+}
@@ -0,0 +1,214 @@
+package presence
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/garlicgarrison/chessvars-backend/pkg/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+// testStore runs the same checks against any Store
+func testStore(t *testing.T, store Store) {
+	ctx := context.Background()
+	now := time.Now()
+
+	assert.NoError(t, store.Refresh(ctx, "test:a", "first", now.Add(time.Minute)))
+	assert.NoError(t, store.Refresh(ctx, "test:a", "second", now.Add(2*time.Minute)))
+	assert.NoError(t, store.Refresh(ctx, "test:a", "expired", now.Add(-time.Second)))
+
+	members, err := store.Members(ctx, "test:a", now)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"second", "first"}, members)
+
+	// a refresh keeps the member for longer
+	assert.NoError(t, store.Refresh(ctx, "test:a", "first", now.Add(3*time.Minute)))
+	members, err = store.Members(ctx, "test:a", now.Add(90*time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"first", "second"}, members)
+
+	expiry, err := store.Expiry(ctx, "test:a", "first", now)
+	assert.NoError(t, err)
+	assert.Equal(t, now.Add(3*time.Minute).UnixMilli(), expiry.UnixMilli())
+	expiry, err = store.Expiry(ctx, "test:a", "expired", now)
+	assert.NoError(t, err)
+	assert.True(t, expiry.IsZero())
+
+	assert.NoError(t, store.Remove(ctx, "test:a", "first"))
+	assert.NoError(t, store.Remove(ctx, "test:a", "second"))
+	members, err = store.Members(ctx, "test:a", now)
+	assert.NoError(t, err)
+	assert.Empty(t, members)
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, NewMemoryStore())
+}
+
+// TestRedisStore needs a redis at REDIS_URL, such as a local one
+// started with `redis-server`
+func TestRedisStore(t *testing.T) {
+	url := os.Getenv("REDIS_URL")
+	if url == "" {
+		t.Skip("REDIS_URL not set")
+	}
+
+	client, err := redis.NewRedisClient(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	store, err := NewRedisStore(client)
+	assert.NoError(t, err)
+	testStore(t, store)
+}
+
+func TestPresence(t *testing.T) {
+	ctx := context.Background()
+	service, err := NewService(Config{})
+	assert.NoError(t, err)
+
+	presence := func(userID format.UserID) *Presence {
+		presences, err := service.GetPresence(ctx, GetPresenceRequest{
+			UserIDs: []format.UserID{userID},
+		})
+		assert.NoError(t, err)
+		return presences[userID]
+	}
+
+	// presence is removed in the background once a context is done
+	eventually := func(userID format.UserID, want Presence) bool {
+		for i := 0; i < 100; i++ {
+			if assert.ObjectsAreEqual(want, *presence(userID)) {
+				return true
+			}
+			time.Sleep(time.Millisecond)
+		}
+		return false
+	}
+
+	assert.Equal(t, &Presence{}, presence("a"))
+
+	tab, closeTab := context.WithCancel(ctx)
+	other, closeOther := context.WithCancel(ctx)
+	assert.NoError(t, service.Connect(tab, ConnectRequest{UserID: "a"}))
+	assert.NoError(t, service.Connect(other, ConnectRequest{UserID: "a"}))
+	entered, err := service.EnterGame(tab, EnterGameRequest{UserID: "a", GameID: "igam1"})
+	assert.NoError(t, err)
+	assert.Equal(t, &Presence{Online: true, GameID: "igam1", GameIDs: []format.GameID{"igam1"}}, presence("a"))
+	assert.True(t, presence("a").InGame("igam1"))
+
+	// still online in the other tab
+	closeTab()
+	<-entered.Left
+	assert.False(t, presence("a").InGame("igam1"))
+	assert.True(t, eventually("a", Presence{Online: true}))
+
+	closeOther()
+	assert.True(t, eventually("a", Presence{}))
+}
+
+func TestViewers(t *testing.T) {
+	ctx := context.Background()
+	service, err := NewService(Config{})
+	assert.NoError(t, err)
+
+	viewers := func() int {
+		reply, err := service.GetViewers(ctx, GetViewersRequest{GameID: "igam1"})
+		assert.NoError(t, err)
+		return reply.Count
+	}
+
+	first, closeFirst := context.WithCancel(ctx)
+	second, closeSecond := context.WithCancel(ctx)
+	watched, err := service.Watch(first, WatchRequest{GameID: "igam1"})
+	assert.NoError(t, err)
+	_, err = service.Watch(second, WatchRequest{GameID: "igam1"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, viewers())
+
+	closeFirst()
+	<-watched.Left
+	assert.Equal(t, 1, viewers())
+	closeSecond()
+}
+
+// TestExpiry checks that a member that stops being refreshed, such as
+// the one of an instance that went away, no longer counts
+func TestExpiry(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	service := &service{store: store}
+
+	now := time.Now()
+	assert.NoError(t, store.Refresh(ctx, viewersKey("igam1"), "gone", now.Add(time.Millisecond)))
+	time.Sleep(2 * time.Millisecond)
+
+	reply, err := service.GetViewers(ctx, GetViewersRequest{GameID: "igam1"})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, reply.Count)
+}
+
+func TestSearch(t *testing.T) {
+	ctx := context.Background()
+	service, err := NewService(Config{})
+	assert.NoError(t, err)
+
+	searching := func() bool {
+		presences, err := service.GetPresence(ctx, GetPresenceRequest{
+			UserIDs: []format.UserID{"a"},
+		})
+		assert.NoError(t, err)
+		return presences["a"].Searching
+	}
+
+	// a reconnect subscribes again before the old subscription is closed
+	old, closeOld := context.WithCancel(ctx)
+	reconnect, closeReconnect := context.WithCancel(ctx)
+	searched, err := service.Search(old, SearchRequest{UserID: "a"})
+	assert.NoError(t, err)
+	_, err = service.Search(reconnect, SearchRequest{UserID: "a"})
+	assert.NoError(t, err)
+
+	closeOld()
+	<-searched.Left
+	assert.True(t, searching())
+
+	closeReconnect()
+}
+
+func TestAway(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	service := &service{store: store}
+
+	away := func(userID format.UserID) time.Time {
+		reply, err := service.GetAway(ctx, GetAwayRequest{UserID: userID, GameID: "igam1"})
+		assert.NoError(t, err)
+		return reply.Since
+	}
+
+	subCtx, cancel := context.WithCancel(ctx)
+	entered, err := service.EnterGame(subCtx, EnterGameRequest{UserID: "a", GameID: "igam1"})
+	assert.NoError(t, err)
+	assert.True(t, away("a").IsZero())
+
+	start := time.Now()
+	cancel()
+	<-entered.Left
+	assert.Eventually(t, func() bool {
+		return !away("a").Before(start)
+	}, time.Second, time.Millisecond)
+
+	// a player whose instance went down was last seen with its last heartbeat
+	seen := time.Now().Add(-time.Minute)
+	assert.NoError(t, store.Refresh(ctx, seenKey("igam1"), "b", seen.Add(SEEN_TTL)))
+	assert.Equal(t, seen.UnixMilli(), away("b").UnixMilli())
+
+	// and nothing is known of a player that never was in the game
+	assert.True(t, away("c").IsZero())
+}
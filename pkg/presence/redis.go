@@ -0,0 +1,74 @@
+package presence
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+// REDIS_PRESENCE_PREFIX is where the keys of a redis Store are kept
+const REDIS_PRESENCE_PREFIX = "presence:"
+
+// redisStore is a Store shared by every instance.
+//
+// The members of each key are a sorted set scored by their expiry, and
+// the key expires with the member refreshed last, as every member is
+// refreshed for as long as the others.
+type redisStore struct {
+	client *redis.Client
+}
+
+func NewRedisStore(client *redis.Client) (Store, error) {
+	if client == nil {
+		return nil, errors.New("redis client required")
+	}
+
+	return &redisStore{
+		client: client,
+	}, nil
+}
+
+func score(t time.Time) float64 {
+	return float64(t.UnixMilli())
+}
+
+func (s *redisStore) Refresh(_ context.Context, key, member string, expiry time.Time) error {
+	key = REDIS_PRESENCE_PREFIX + key
+
+	_, err := s.client.TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(key, redis.Z{Score: score(expiry), Member: member})
+		pipe.ZRemRangeByScore(key, "-inf", "("+strconv.FormatFloat(score(time.Now()), 'f', 0, 64))
+		pipe.Expire(key, time.Until(expiry))
+		return nil
+	})
+	return err
+}
+
+func (s *redisStore) Remove(_ context.Context, key, member string) error {
+	return s.client.ZRem(REDIS_PRESENCE_PREFIX+key, member).Err()
+}
+
+func (s *redisStore) Expiry(_ context.Context, key, member string, now time.Time) (time.Time, error) {
+	expiry, err := s.client.ZScore(REDIS_PRESENCE_PREFIX+key, member).Result()
+	if err == redis.Nil {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if expiry <= score(now) {
+		return time.Time{}, nil
+	}
+	return time.UnixMilli(int64(expiry)), nil
+}
+
+func (s *redisStore) Members(_ context.Context, key string, now time.Time) ([]string, error) {
+	return s.client.ZRevRangeByScore(REDIS_PRESENCE_PREFIX+key, redis.ZRangeBy{
+		Min: "(" + strconv.FormatFloat(score(now), 'f', 0, 64),
+		Max: "+inf",
+	}).Result()
+}
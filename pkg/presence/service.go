@@ -0,0 +1,268 @@
+package presence
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/google/uuid"
+)
+
+const (
+	// HEARTBEAT_INTERVAL is how often a connection is refreshed,
+	// and PRESENCE_TTL how long it is kept without one, which leaves
+	// room for a missed heartbeat
+	HEARTBEAT_INTERVAL = 15 * time.Second
+	PRESENCE_TTL       = 3 * HEARTBEAT_INTERVAL
+
+	// SEEN_TTL is how long the last time a player was in a game is kept,
+	// which is how long GetAway can tell since when it is away
+	SEEN_TTL = 24 * time.Hour
+)
+
+type Config struct {
+	// Store keeps presence in memory when nil
+	Store Store
+}
+
+type service struct {
+	store Store
+}
+
+func NewService(cfg Config) (Service, error) {
+	store := cfg.Store
+	if store == nil {
+		store = NewMemoryStore()
+	}
+
+	return &service{
+		store: store,
+	}, nil
+}
+
+func onlineKey(userID format.UserID) string {
+	return "online:" + userID.String()
+}
+
+func gamesKey(userID format.UserID) string {
+	return "games:" + userID.String()
+}
+
+func searchKey(userID format.UserID) string {
+	return "search:" + userID.String()
+}
+
+func viewersKey(gameID format.GameID) string {
+	return "viewers:" + gameID.String()
+}
+
+func seenKey(gameID format.GameID) string {
+	return "seen:" + gameID.String()
+}
+
+// keep refreshes the member of the key until ctx is done, removing
+// it once it is, after which the returned channel is closed
+func (s *service) keep(ctx context.Context, key, member string) (<-chan struct{}, error) {
+	err := s.store.Refresh(ctx, key, member, time.Now().Add(PRESENCE_TTL))
+	if err != nil {
+		return nil, err
+	}
+
+	removed := make(chan struct{})
+	go func() {
+		defer close(removed)
+
+		ticker := time.NewTicker(HEARTBEAT_INTERVAL)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				// ctx is done, but the member still has to go
+				err := s.store.Remove(context.Background(), key, member)
+				if err != nil {
+					log.Printf("could not remove presence: %s", err)
+				}
+				return
+			case now := <-ticker.C:
+				err := s.store.Refresh(ctx, key, member, now.Add(PRESENCE_TTL))
+				if err != nil {
+					log.Printf("could not refresh presence: %s", err)
+				}
+			}
+		}
+	}()
+
+	return removed, nil
+}
+
+// see refreshes the member of the key for SEEN_TTL on every heartbeat and
+// once more when ctx is done, after which it is left to expire, so that
+// it expires SEEN_TTL after the member was last seen
+func (s *service) see(ctx context.Context, key, member string) error {
+	err := s.store.Refresh(ctx, key, member, time.Now().Add(SEEN_TTL))
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		ticker := time.NewTicker(HEARTBEAT_INTERVAL)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				err := s.store.Refresh(context.Background(), key, member, time.Now().Add(SEEN_TTL))
+				if err != nil {
+					log.Printf("could not refresh last seen: %s", err)
+				}
+				return
+			case now := <-ticker.C:
+				err := s.store.Refresh(ctx, key, member, now.Add(SEEN_TTL))
+				if err != nil {
+					log.Printf("could not refresh last seen: %s", err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (s *service) Connect(ctx context.Context, request ConnectRequest) error {
+	if request.UserID == "" {
+		return errors.New("user required")
+	}
+
+	_, err := s.keep(ctx, onlineKey(request.UserID), uuid.NewString())
+	return err
+}
+
+func (s *service) EnterGame(ctx context.Context, request EnterGameRequest) (*EnterGameResponse, error) {
+	if request.UserID == "" {
+		return nil, errors.New("user required")
+	}
+
+	// every subscription is a member of its own, so that
+	// leaving the game takes all of them
+	member := request.GameID.String() + "/" + uuid.NewString()
+	left, err := s.keep(ctx, gamesKey(request.UserID), member)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.see(ctx, seenKey(request.GameID), request.UserID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &EnterGameResponse{
+		Left: left,
+	}, nil
+}
+
+func (s *service) Search(ctx context.Context, request SearchRequest) (*SearchResponse, error) {
+	if request.UserID == "" {
+		return nil, errors.New("user required")
+	}
+
+	left, err := s.keep(ctx, searchKey(request.UserID), uuid.NewString())
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchResponse{
+		Left: left,
+	}, nil
+}
+
+func (s *service) GetPresence(ctx context.Context, request GetPresenceRequest) (map[format.UserID]*Presence, error) {
+	now := time.Now()
+
+	presences := make(map[format.UserID]*Presence)
+	for _, userID := range request.UserIDs {
+		connections, err := s.store.Members(ctx, onlineKey(userID), now)
+		if err != nil {
+			return nil, err
+		}
+
+		games, err := s.store.Members(ctx, gamesKey(userID), now)
+		if err != nil {
+			return nil, err
+		}
+
+		searches, err := s.store.Members(ctx, searchKey(userID), now)
+		if err != nil {
+			return nil, err
+		}
+
+		presence := &Presence{
+			Online:    len(connections) > 0,
+			Searching: len(searches) > 0,
+		}
+		for _, member := range games {
+			gameID, _, _ := strings.Cut(member, "/")
+			if !presence.InGame(format.GameID(gameID)) {
+				presence.GameIDs = append(presence.GameIDs, format.GameID(gameID))
+			}
+		}
+		if len(presence.GameIDs) > 0 {
+			presence.GameID = presence.GameIDs[0]
+		}
+		presences[userID] = presence
+	}
+
+	return presences, nil
+}
+
+func (s *service) GetAway(ctx context.Context, request GetAwayRequest) (*GetAwayResponse, error) {
+	now := time.Now()
+
+	games, err := s.store.Members(ctx, gamesKey(request.UserID), now)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range games {
+		gameID, _, _ := strings.Cut(member, "/")
+		if format.GameID(gameID) == request.GameID {
+			return &GetAwayResponse{}, nil
+		}
+	}
+
+	expiry, err := s.store.Expiry(ctx, seenKey(request.GameID), request.UserID.String(), now)
+	if err != nil {
+		return nil, err
+	}
+	if expiry.IsZero() {
+		return &GetAwayResponse{}, nil
+	}
+
+	return &GetAwayResponse{
+		Since: expiry.Add(-SEEN_TTL),
+	}, nil
+}
+
+func (s *service) Watch(ctx context.Context, request WatchRequest) (*WatchResponse, error) {
+	left, err := s.keep(ctx, viewersKey(request.GameID), uuid.NewString())
+	if err != nil {
+		return nil, err
+	}
+
+	return &WatchResponse{
+		Left: left,
+	}, nil
+}
+
+func (s *service) GetViewers(ctx context.Context, request GetViewersRequest) (*GetViewersResponse, error) {
+	viewers, err := s.store.Members(ctx, viewersKey(request.GameID), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetViewersResponse{
+		Count: len(viewers),
+	}, nil
+}
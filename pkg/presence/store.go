@@ -0,0 +1,92 @@
+package presence
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Store keeps members under keys until they expire, unless they are
+// refreshed first, possibly across instances
+type Store interface {
+	// Refresh adds the member to the key, or keeps it, until expiry
+	Refresh(ctx context.Context, key, member string, expiry time.Time) error
+	Remove(ctx context.Context, key, member string) error
+	// Members returns the members of the key that have not expired at now,
+	// the one that expires last first
+	Members(ctx context.Context, key string, now time.Time) ([]string, error)
+	// Expiry returns when the member of the key expires,
+	// which is zero if it is not there or already expired at now
+	Expiry(ctx context.Context, key, member string, now time.Time) (time.Time, error)
+}
+
+// memoryStore is a Store for a single instance
+type memoryStore struct {
+	mu      sync.Mutex
+	members map[string]map[string]time.Time
+}
+
+func NewMemoryStore() Store {
+	return &memoryStore{
+		members: make(map[string]map[string]time.Time),
+	}
+}
+
+func (s *memoryStore) Refresh(_ context.Context, key, member string, expiry time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	members, ok := s.members[key]
+	if !ok {
+		members = make(map[string]time.Time)
+		s.members[key] = members
+	}
+	members[member] = expiry
+	return nil
+}
+
+func (s *memoryStore) Remove(_ context.Context, key, member string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.members[key], member)
+	if len(s.members[key]) == 0 {
+		delete(s.members, key)
+	}
+	return nil
+}
+
+func (s *memoryStore) Members(_ context.Context, key string, now time.Time) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	members := make([]string, 0)
+	for member, expiry := range s.members[key] {
+		if expiry.After(now) {
+			members = append(members, member)
+		} else {
+			delete(s.members[key], member)
+		}
+	}
+	if len(s.members[key]) == 0 {
+		delete(s.members, key)
+	}
+
+	expiries := s.members[key]
+	sort.Slice(members, func(i, j int) bool {
+		return expiries[members[i]].After(expiries[members[j]])
+	})
+	return members, nil
+}
+
+func (s *memoryStore) Expiry(_ context.Context, key, member string, now time.Time) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expiry, ok := s.members[key][member]
+	if !ok || !expiry.After(now) {
+		return time.Time{}, nil
+	}
+	return expiry, nil
+}
@@ -0,0 +1,63 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+)
+
+// memoryBroker is a Broker for a single instance
+type memoryBroker struct {
+	mu          sync.Mutex
+	subscribers map[string][]chan []byte
+}
+
+func NewMemoryBroker() Broker {
+	return &memoryBroker{
+		subscribers: make(map[string][]chan []byte),
+	}
+}
+
+func (b *memoryBroker) Publish(_ context.Context, topic string, data []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, c := range b.subscribers[topic] {
+		// a subscriber that is not reading does not hold up the others
+		select {
+		case c <- data:
+		default:
+		}
+	}
+	return nil
+}
+
+func (b *memoryBroker) Subscribe(ctx context.Context, topic string) (<-chan []byte, error) {
+	c := make(chan []byte, SUBSCRIBER_BUFFER)
+
+	b.mu.Lock()
+	b.subscribers[topic] = append(b.subscribers[topic], c)
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		subscribers := b.subscribers[topic]
+		for i, sub := range subscribers {
+			if sub == c {
+				subscribers = append(subscribers[:i], subscribers[i+1:]...)
+				break
+			}
+		}
+		if len(subscribers) == 0 {
+			delete(b.subscribers, topic)
+		} else {
+			b.subscribers[topic] = subscribers
+		}
+		close(c)
+	}()
+
+	return c, nil
+}
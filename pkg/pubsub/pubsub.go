@@ -0,0 +1,16 @@
+package pubsub
+
+import "context"
+
+// Broker delivers what is published on a topic to everyone
+// subscribed to it, possibly across instances
+type Broker interface {
+	Publish(ctx context.Context, topic string, data []byte) error
+	// Subscribe streams the messages of the topic until ctx is done,
+	// starting with the first one published after it returns
+	Subscribe(ctx context.Context, topic string) (<-chan []byte, error)
+}
+
+// SUBSCRIBER_BUFFER is how many messages a subscriber can fall behind
+// before the ones after are dropped for it
+const SUBSCRIBER_BUFFER = 16
@@ -0,0 +1,90 @@
+package pubsub
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+// testBroker runs the same checks against any Broker
+func testBroker(t *testing.T, broker Broker) {
+	ctx := context.Background()
+
+	receive := func(t *testing.T, c <-chan []byte) []byte {
+		select {
+		case data := <-c:
+			return data
+		case <-time.After(time.Second):
+			t.Fatal("no message received")
+			return nil
+		}
+	}
+
+	t.Run("delivers to every subscriber of the topic", func(t *testing.T) {
+		subCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		a, err := broker.Subscribe(subCtx, "game:a")
+		assert.NoError(t, err)
+		b, err := broker.Subscribe(subCtx, "game:a")
+		assert.NoError(t, err)
+		other, err := broker.Subscribe(subCtx, "game:b")
+		assert.NoError(t, err)
+
+		err = broker.Publish(ctx, "game:a", []byte("7g7f"))
+		assert.NoError(t, err)
+
+		assert.Equal(t, []byte("7g7f"), receive(t, a))
+		assert.Equal(t, []byte("7g7f"), receive(t, b))
+		select {
+		case data := <-other:
+			t.Fatalf("received %s on another topic", data)
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+
+	t.Run("closes once ctx is done", func(t *testing.T) {
+		subCtx, cancel := context.WithCancel(ctx)
+
+		c, err := broker.Subscribe(subCtx, "game:c")
+		assert.NoError(t, err)
+		cancel()
+
+		select {
+		case _, ok := <-c:
+			assert.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("subscription was not closed")
+		}
+
+		// publishing without subscribers is fine
+		assert.NoError(t, broker.Publish(ctx, "game:c", []byte("3c3d")))
+	})
+}
+
+func TestMemoryBroker(t *testing.T) {
+	testBroker(t, NewMemoryBroker())
+}
+
+// TestRedisBroker needs a redis at REDIS_URL, such as a local one
+// started with `redis-server`
+func TestRedisBroker(t *testing.T) {
+	url := os.Getenv("REDIS_URL")
+	if url == "" {
+		t.Skip("REDIS_URL not set")
+	}
+
+	client, err := redis.NewRedisClient(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	broker, err := NewRedisBroker(client)
+	assert.NoError(t, err)
+	testBroker(t, broker)
+}
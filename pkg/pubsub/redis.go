@@ -0,0 +1,69 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-redis/redis"
+)
+
+// REDIS_TOPIC_PREFIX keeps the channels of the broker apart from
+// any other use of redis pub/sub
+const REDIS_TOPIC_PREFIX = "pubsub:"
+
+// redisBroker is a Broker shared by every instance
+// connected to the same redis
+type redisBroker struct {
+	client *redis.Client
+}
+
+func NewRedisBroker(client *redis.Client) (Broker, error) {
+	if client == nil {
+		return nil, errors.New("redis client required")
+	}
+
+	return &redisBroker{
+		client: client,
+	}, nil
+}
+
+func (b *redisBroker) Publish(_ context.Context, topic string, data []byte) error {
+	return b.client.Publish(REDIS_TOPIC_PREFIX+topic, data).Err()
+}
+
+func (b *redisBroker) Subscribe(ctx context.Context, topic string) (<-chan []byte, error) {
+	pubsub := b.client.Subscribe(REDIS_TOPIC_PREFIX + topic)
+
+	// wait for the subscription so that no message published after
+	// this returns is missed
+	_, err := pubsub.Receive()
+	if err != nil {
+		pubsub.Close()
+		return nil, err
+	}
+
+	c := make(chan []byte, SUBSCRIBER_BUFFER)
+	go func() {
+		defer close(c)
+		defer pubsub.Close()
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				select {
+				case c <- []byte(msg.Payload):
+				default:
+				}
+			}
+		}
+	}()
+
+	return c, nil
+}
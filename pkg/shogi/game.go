@@ -0,0 +1,126 @@
+package shogi
+
+import "fmt"
+
+// REPETITIONS is the number of times a position has to occur
+// for sennichite
+const REPETITIONS = 4
+
+type Result int
+
+const (
+	ONGOING Result = iota
+	CHECKMATE
+	// STALEMATE is a loss for the side without moves
+	STALEMATE
+	// SENNICHITE is a draw by repetition
+	SENNICHITE
+	// PERPETUAL_CHECK is a repetition where one side gave check
+	// on every move, which loses for that side
+	PERPETUAL_CHECK
+)
+
+// Outcome is the state of a game after the last move.
+//
+// Winner is meaningful for every finished result except SENNICHITE.
+type Outcome struct {
+	Result Result
+	Winner Color
+}
+
+func (o Outcome) Ended() bool {
+	return o.Result != ONGOING
+}
+
+func (o Outcome) Draw() bool {
+	return o.Result == SENNICHITE
+}
+
+// Game is a position along with the history needed for sennichite
+type Game struct {
+	pos *Position
+
+	// keys[i] is the position after ply i, keys[0] being the start
+	keys []string
+	// checks[i] is true if ply i gave check
+	checks  []bool
+	outcome Outcome
+}
+
+func NewGame() *Game {
+	pos := NewPosition()
+	return &Game{
+		pos:    pos,
+		keys:   []string{pos.Key()},
+		checks: []bool{false},
+	}
+}
+
+func (g *Game) Position() *Position {
+	return g.pos
+}
+
+func (g *Game) Outcome() Outcome {
+	return g.outcome
+}
+
+// Play makes the move if it is legal and the game has not ended,
+// then works out the outcome of the new position
+func (g *Game) Play(m Move) error {
+	if g.outcome.Ended() {
+		return fmt.Errorf("game has already ended")
+	}
+
+	mover := g.pos.Turn()
+	err := g.pos.Play(m)
+	if err != nil {
+		return err
+	}
+
+	key := g.pos.Key()
+	check := g.pos.InCheck(g.pos.Turn())
+	g.keys = append(g.keys, key)
+	g.checks = append(g.checks, check)
+
+	if !g.pos.hasLegalMoves() {
+		if check {
+			g.outcome = Outcome{Result: CHECKMATE, Winner: mover}
+		} else {
+			g.outcome = Outcome{Result: STALEMATE, Winner: mover}
+		}
+		return nil
+	}
+
+	first, count := -1, 0
+	for i, k := range g.keys {
+		if k == key {
+			if first < 0 {
+				first = i
+			}
+			count++
+		}
+	}
+	if count < REPETITIONS {
+		return nil
+	}
+
+	// the cycle is every ply since the first occurrence, where the
+	// mover played the last ply and the opponent the one before it
+	for offset, checker := range [2]Color{mover, mover.Other()} {
+		perpetual := true
+		for i := len(g.keys) - 1 - offset; i > first; i -= 2 {
+			if !g.checks[i] {
+				perpetual = false
+				break
+			}
+		}
+
+		if perpetual {
+			g.outcome = Outcome{Result: PERPETUAL_CHECK, Winner: checker.Other()}
+			return nil
+		}
+	}
+
+	g.outcome = Outcome{Result: SENNICHITE}
+	return nil
+}
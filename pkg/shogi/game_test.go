@@ -0,0 +1,74 @@
+package shogi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func play(t *testing.T, g *Game, moves ...string) {
+	for _, str := range moves {
+		m, err := ParseMove(str)
+		assert.NoError(t, err)
+		assert.NoError(t, g.Play(m), str)
+	}
+}
+
+func TestCheckmate(t *testing.T) {
+	pos := newEmptyPosition(SENTE, map[string]Piece{
+		"5i": {Kind: KING, Color: SENTE},
+		"1a": {Kind: KING, Color: GOTE},
+		"2a": {Kind: KNIGHT, Color: GOTE},
+		"2b": {Kind: SILVER, Color: GOTE},
+		"1c": {Kind: GOLD, Color: SENTE},
+	})
+	pos.hands[SENTE][GOLD] = 1
+	g := &Game{
+		pos:    pos,
+		keys:   []string{pos.Key()},
+		checks: []bool{false},
+	}
+
+	play(t, g, "G*1b")
+	assert.Equal(t, Outcome{Result: CHECKMATE, Winner: SENTE}, g.Outcome())
+	assert.False(t, g.Outcome().Draw())
+
+	m, _ := ParseMove("2b3c")
+	assert.Error(t, g.Play(m), "game is over")
+}
+
+func TestSennichite(t *testing.T) {
+	g := NewGame()
+
+	shuffle := []string{"2h3h", "8b7b", "3h2h", "7b8b"}
+	play(t, g, shuffle...)
+	play(t, g, shuffle...)
+	assert.False(t, g.Outcome().Ended())
+
+	play(t, g, shuffle...)
+	assert.Equal(t, SENNICHITE, g.Outcome().Result)
+	assert.True(t, g.Outcome().Draw())
+}
+
+func TestPerpetualCheck(t *testing.T) {
+	pos := newEmptyPosition(SENTE, map[string]Piece{
+		"5i": {Kind: KING, Color: SENTE},
+		"5a": {Kind: KING, Color: GOTE},
+		"1b": {Kind: ROOK, Color: SENTE},
+	})
+	g := &Game{
+		pos:    pos,
+		keys:   []string{pos.Key()},
+		checks: []bool{false},
+	}
+
+	// sente checks along the rank of the king on every move
+	cycle := []string{"1b1a", "5a5b", "1a1b", "5b5a"}
+	play(t, g, cycle...)
+	play(t, g, cycle...)
+	play(t, g, "1b1a", "5a5b")
+	assert.False(t, g.Outcome().Ended())
+
+	play(t, g, "1a1b", "5b5a")
+	assert.Equal(t, Outcome{Result: PERPETUAL_CHECK, Winner: GOTE}, g.Outcome())
+}
@@ -0,0 +1,103 @@
+package shogi
+
+import (
+	"fmt"
+)
+
+const (
+	FILES = 9
+	RANKS = 9
+)
+
+// Square is a point on the board.
+//
+// Files are numbered 1-9 from sente's right and ranks are lettered a-i
+// from gote's side, so "7g" is Square{File: 6, Rank: 6}.
+type Square struct {
+	File int
+	Rank int
+}
+
+func (s Square) valid() bool {
+	return s.File >= 0 && s.File < FILES && s.Rank >= 0 && s.Rank < RANKS
+}
+
+func (s Square) add(file, rank int) Square {
+	return Square{File: s.File + file, Rank: s.Rank + rank}
+}
+
+func (s Square) index() int {
+	return s.Rank*FILES + s.File
+}
+
+func (s Square) String() string {
+	return fmt.Sprintf("%c%c", '1'+s.File, 'a'+s.Rank)
+}
+
+// ParseSquare parses a USI square such as "7g"
+func ParseSquare(str string) (Square, error) {
+	if len(str) != 2 {
+		return Square{}, fmt.Errorf("invalid square: %s", str)
+	}
+
+	sq := Square{File: int(str[0] - '1'), Rank: int(str[1] - 'a')}
+	if !sq.valid() {
+		return Square{}, fmt.Errorf("invalid square: %s", str)
+	}
+
+	return sq, nil
+}
+
+// Move is either a board move from one square to another,
+// or a drop of a piece in hand when Drop is set
+type Move struct {
+	From    Square
+	To      Square
+	Promote bool
+	Drop    Kind
+}
+
+func (m Move) String() string {
+	if m.Drop != NONE {
+		return fmt.Sprintf("%c*%s", kindLetters[m.Drop], m.To)
+	}
+
+	str := m.From.String() + m.To.String()
+	if m.Promote {
+		str += "+"
+	}
+	return str
+}
+
+// ParseMove parses a move in USI notation, i.e. "7g7f", "8h2b+" or "P*5e"
+func ParseMove(str string) (Move, error) {
+	if len(str) == 4 && str[1] == '*' {
+		kind, ok := parseKind(str[0])
+		if !ok || kind == KING {
+			return Move{}, fmt.Errorf("invalid move: %s", str)
+		}
+
+		to, err := ParseSquare(str[2:])
+		if err != nil {
+			return Move{}, fmt.Errorf("invalid move: %s", str)
+		}
+
+		return Move{To: to, Drop: kind}, nil
+	}
+
+	if len(str) != 4 && !(len(str) == 5 && str[4] == '+') {
+		return Move{}, fmt.Errorf("invalid move: %s", str)
+	}
+
+	from, err := ParseSquare(str[:2])
+	if err != nil {
+		return Move{}, fmt.Errorf("invalid move: %s", str)
+	}
+
+	to, err := ParseSquare(str[2:4])
+	if err != nil {
+		return Move{}, fmt.Errorf("invalid move: %s", str)
+	}
+
+	return Move{From: from, To: to, Promote: len(str) == 5}, nil
+}
@@ -0,0 +1,165 @@
+package shogi
+
+var (
+	orthogonals = [][2]int{{0, 1}, {0, -1}, {1, 0}, {-1, 0}}
+	diagonals   = [][2]int{{1, 1}, {1, -1}, {-1, 1}, {-1, -1}}
+)
+
+// steps returns the single step directions of a piece, relative to
+// a forward direction of f
+func steps(piece Piece, f int) [][2]int {
+	gold := [][2]int{{0, f}, {1, f}, {-1, f}, {1, 0}, {-1, 0}, {0, -f}}
+
+	if piece.Promoted {
+		switch piece.Kind {
+		case ROOK:
+			return diagonals
+		case BISHOP:
+			return orthogonals
+		default:
+			return gold
+		}
+	}
+
+	switch piece.Kind {
+	case KING:
+		return append(append([][2]int{}, orthogonals...), diagonals...)
+	case GOLD:
+		return gold
+	case SILVER:
+		return [][2]int{{0, f}, {1, f}, {-1, f}, {1, -f}, {-1, -f}}
+	case KNIGHT:
+		return [][2]int{{1, 2 * f}, {-1, 2 * f}}
+	case PAWN:
+		return [][2]int{{0, f}}
+	}
+	return nil
+}
+
+// slides returns the sliding directions of a piece
+func slides(piece Piece, f int) [][2]int {
+	switch piece.Kind {
+	case ROOK:
+		return orthogonals
+	case BISHOP:
+		return diagonals
+	case LANCE:
+		if !piece.Promoted {
+			return [][2]int{{0, f}}
+		}
+	}
+	return nil
+}
+
+// ranksFromEnd returns how far sq is from the far side of the board
+// for the given color, where 0 is the last rank
+func ranksFromEnd(sq Square, color Color) int {
+	if color == SENTE {
+		return sq.Rank
+	}
+	return RANKS - 1 - sq.Rank
+}
+
+func inPromotionZone(sq Square, color Color) bool {
+	return ranksFromEnd(sq, color) <= 2
+}
+
+// mustPromote returns true if the piece would have no moves left on sq
+func mustPromote(kind Kind, sq Square, color Color) bool {
+	switch kind {
+	case PAWN, LANCE:
+		return ranksFromEnd(sq, color) == 0
+	case KNIGHT:
+		return ranksFromEnd(sq, color) <= 1
+	}
+	return false
+}
+
+// pieceTargets returns the squares the piece on sq attacks
+func (p *Position) pieceTargets(sq Square) []Square {
+	piece := p.At(sq)
+	f := piece.Color.forward()
+
+	targets := make([]Square, 0)
+	for _, d := range steps(piece, f) {
+		target := sq.add(d[0], d[1])
+		if target.valid() {
+			targets = append(targets, target)
+		}
+	}
+	for _, d := range slides(piece, f) {
+		for target := sq.add(d[0], d[1]); target.valid(); target = target.add(d[0], d[1]) {
+			targets = append(targets, target)
+			if !p.At(target).Empty() {
+				break
+			}
+		}
+	}
+	return targets
+}
+
+func (p *Position) pseudoLegalMoves() []Move {
+	moves := make([]Move, 0)
+	for i, piece := range p.board {
+		if piece.Empty() || piece.Color != p.turn {
+			continue
+		}
+		moves = append(moves, p.pieceMoves(Square{File: i % FILES, Rank: i / FILES})...)
+	}
+	for _, kind := range handKinds {
+		moves = append(moves, p.dropMoves(kind)...)
+	}
+	return moves
+}
+
+// pieceMoves returns the moves of the piece on sq along with
+// their promotions, ignoring checks
+func (p *Position) pieceMoves(sq Square) []Move {
+	piece := p.At(sq)
+
+	moves := make([]Move, 0)
+	for _, target := range p.pieceTargets(sq) {
+		occupant := p.At(target)
+		if !occupant.Empty() && occupant.Color == piece.Color {
+			continue
+		}
+
+		canPromote := piece.Kind.promotable() && !piece.Promoted &&
+			(inPromotionZone(sq, piece.Color) || inPromotionZone(target, piece.Color))
+		if canPromote {
+			moves = append(moves, Move{From: sq, To: target, Promote: true})
+		}
+		if piece.Promoted || !mustPromote(piece.Kind, target, piece.Color) {
+			moves = append(moves, Move{From: sq, To: target})
+		}
+	}
+	return moves
+}
+
+// dropMoves returns the drops of the given kind from the hand of the
+// side to move, ignoring checks and uchifuzume
+func (p *Position) dropMoves(kind Kind) []Move {
+	if p.hands[p.turn][kind] == 0 {
+		return nil
+	}
+
+	// nifu: no two unpromoted pawns of the same color on a file
+	pawnFiles := [FILES]bool{}
+	if kind == PAWN {
+		for i, piece := range p.board {
+			if piece.Kind == PAWN && piece.Color == p.turn && !piece.Promoted {
+				pawnFiles[i%FILES] = true
+			}
+		}
+	}
+
+	moves := make([]Move, 0)
+	for i, piece := range p.board {
+		sq := Square{File: i % FILES, Rank: i / FILES}
+		if !piece.Empty() || mustPromote(kind, sq, p.turn) || pawnFiles[sq.File] {
+			continue
+		}
+		moves = append(moves, Move{To: sq, Drop: kind})
+	}
+	return moves
+}
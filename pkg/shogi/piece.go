@@ -0,0 +1,101 @@
+package shogi
+
+// Color is the side a piece belongs to.
+//
+// NOTE: sente always moves first, and sits on ranks g-i at the start
+type Color int
+
+const (
+	SENTE Color = iota
+	GOTE
+)
+
+func (c Color) Other() Color {
+	if c == SENTE {
+		return GOTE
+	}
+	return SENTE
+}
+
+func (c Color) String() string {
+	if c == SENTE {
+		return "sente"
+	}
+	return "gote"
+}
+
+// forward is the rank direction the color moves towards
+func (c Color) forward() int {
+	if c == SENTE {
+		return -1
+	}
+	return 1
+}
+
+type Kind int
+
+const (
+	NONE Kind = iota
+	PAWN
+	LANCE
+	KNIGHT
+	SILVER
+	GOLD
+	BISHOP
+	ROOK
+	KING
+)
+
+// handKinds are the kinds that can be held in hand and dropped
+var handKinds = []Kind{PAWN, LANCE, KNIGHT, SILVER, GOLD, BISHOP, ROOK}
+
+var kindLetters = map[Kind]byte{
+	PAWN:   'P',
+	LANCE:  'L',
+	KNIGHT: 'N',
+	SILVER: 'S',
+	GOLD:   'G',
+	BISHOP: 'B',
+	ROOK:   'R',
+	KING:   'K',
+}
+
+func parseKind(letter byte) (Kind, bool) {
+	for kind, l := range kindLetters {
+		if l == letter {
+			return kind, true
+		}
+	}
+	return NONE, false
+}
+
+func (k Kind) promotable() bool {
+	return k != NONE && k != GOLD && k != KING
+}
+
+type Piece struct {
+	Kind     Kind
+	Color    Color
+	Promoted bool
+}
+
+func (p Piece) Empty() bool {
+	return p.Kind == NONE
+}
+
+// String returns the USI letter of the piece, upper case for sente and
+// lower case for gote. Empty squares are returned as ".".
+func (p Piece) String() string {
+	if p.Empty() {
+		return "."
+	}
+
+	letter := kindLetters[p.Kind]
+	if p.Color == GOTE {
+		letter += 'a' - 'A'
+	}
+	if p.Promoted {
+		return "+" + string(letter)
+	}
+	return string(letter)
+}
@@ -0,0 +1,287 @@
+package shogi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Position is a shogi board, the pieces in hand and the side to move
+type Position struct {
+	board [FILES * RANKS]Piece
+	hands [2][KING]int
+	turn  Color
+}
+
+// back rank from file 1 to 9, mirrored for both sides
+var backRank = [FILES]Kind{
+	LANCE, KNIGHT, SILVER, GOLD, KING, GOLD, SILVER, KNIGHT, LANCE,
+}
+
+// NewPosition returns the starting position with sente to move
+func NewPosition() *Position {
+	p := &Position{turn: SENTE}
+
+	for color, ranks := range map[Color][3]int{
+		// back rank, rook and bishop, pawns
+		SENTE: {8, 7, 6},
+		GOTE:  {0, 1, 2},
+	} {
+		for file, kind := range backRank {
+			p.set(Square{File: file, Rank: ranks[0]}, Piece{Kind: kind, Color: color})
+		}
+		for file := 0; file < FILES; file++ {
+			p.set(Square{File: file, Rank: ranks[2]}, Piece{Kind: PAWN, Color: color})
+		}
+	}
+
+	// the rook is on the right of each player
+	p.set(Square{File: 1, Rank: 7}, Piece{Kind: ROOK, Color: SENTE})
+	p.set(Square{File: 7, Rank: 7}, Piece{Kind: BISHOP, Color: SENTE})
+	p.set(Square{File: 7, Rank: 1}, Piece{Kind: ROOK, Color: GOTE})
+	p.set(Square{File: 1, Rank: 1}, Piece{Kind: BISHOP, Color: GOTE})
+
+	return p
+}
+
+func (p *Position) Turn() Color {
+	return p.turn
+}
+
+// At returns the piece on the given square
+func (p *Position) At(sq Square) Piece {
+	return p.board[sq.index()]
+}
+
+// Hand returns how many pieces of the given kind the color holds
+func (p *Position) Hand(color Color, kind Kind) int {
+	return p.hands[color][kind]
+}
+
+func (p *Position) set(sq Square, piece Piece) {
+	p.board[sq.index()] = piece
+}
+
+func (p *Position) clone() *Position {
+	c := *p
+	return &c
+}
+
+// Key identifies the position for repetition checks
+func (p *Position) Key() string {
+	var b strings.Builder
+	for _, piece := range p.board {
+		b.WriteString(piece.String())
+	}
+	for color := range p.hands {
+		for _, kind := range handKinds {
+			b.WriteString(strconv.Itoa(p.hands[color][kind]))
+		}
+	}
+	b.WriteString(p.turn.String())
+	return b.String()
+}
+
+// String returns the board as seen from sente, with file 9 on the left
+func (p *Position) String() string {
+	var b strings.Builder
+	for rank := 0; rank < RANKS; rank++ {
+		for file := FILES - 1; file >= 0; file-- {
+			b.WriteString(fmt.Sprintf("%2s", p.At(Square{File: file, Rank: rank})))
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func (p *Position) king(color Color) (Square, bool) {
+	for i, piece := range p.board {
+		if piece.Kind == KING && piece.Color == color {
+			return Square{File: i % FILES, Rank: i / FILES}, true
+		}
+	}
+	return Square{}, false
+}
+
+// InCheck returns true if the king of the given color is attacked
+func (p *Position) InCheck(color Color) bool {
+	sq, ok := p.king(color)
+	if !ok {
+		return true
+	}
+
+	return p.attacked(sq, color.Other())
+}
+
+// attacked returns true if any piece of the given color can move to sq
+func (p *Position) attacked(sq Square, by Color) bool {
+	for i, piece := range p.board {
+		if piece.Empty() || piece.Color != by {
+			continue
+		}
+
+		for _, target := range p.pieceTargets(Square{File: i % FILES, Rank: i / FILES}) {
+			if target == sq {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// LegalMoves returns every move the side to move can make
+// without leaving its own king in check
+func (p *Position) LegalMoves() []Move {
+	moves := make([]Move, 0)
+	for _, m := range p.pseudoLegalMoves() {
+		if p.legal(m) {
+			moves = append(moves, m)
+		}
+	}
+	return moves
+}
+
+// hasLegalMoves is a cheaper len(p.LegalMoves()) > 0
+func (p *Position) hasLegalMoves() bool {
+	for _, m := range p.pseudoLegalMoves() {
+		if p.legal(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsLegal checks if the side to move is allowed to play m
+func (p *Position) IsLegal(m Move) bool {
+	if !m.To.valid() {
+		return false
+	}
+
+	var candidates []Move
+	if m.Drop != NONE {
+		candidates = p.dropMoves(m.Drop)
+	} else {
+		if !m.From.valid() {
+			return false
+		}
+
+		piece := p.At(m.From)
+		if piece.Empty() || piece.Color != p.turn {
+			return false
+		}
+		candidates = p.pieceMoves(m.From)
+	}
+
+	for _, c := range candidates {
+		if c == m {
+			return p.legal(m)
+		}
+	}
+	return false
+}
+
+// legal checks a pseudo legal move against checks and uchifuzume
+func (p *Position) legal(m Move) bool {
+	next := p.apply(m)
+	if next.InCheck(p.turn) {
+		return false
+	}
+
+	// uchifuzume: a pawn drop may not deliver checkmate
+	if m.Drop == PAWN && next.InCheck(next.turn) && !next.hasLegalMoves() {
+		return false
+	}
+
+	return true
+}
+
+// Play makes the move if it is legal
+func (p *Position) Play(m Move) error {
+	if !p.IsLegal(m) {
+		return fmt.Errorf("illegal move for %s: %s", p.turn, m)
+	}
+
+	*p = *p.apply(m)
+	return nil
+}
+
+// apply returns a copy of the position with m played,
+// whether or not it was legal
+func (p *Position) apply(m Move) *Position {
+	next := p.clone()
+
+	if m.Drop != NONE {
+		next.hands[p.turn][m.Drop]--
+		next.set(m.To, Piece{Kind: m.Drop, Color: p.turn})
+	} else {
+		captured := next.At(m.To)
+		if !captured.Empty() && captured.Kind != KING {
+			next.hands[p.turn][captured.Kind]++
+		}
+
+		piece := next.At(m.From)
+		if m.Promote {
+			piece.Promoted = true
+		}
+		next.set(m.To, piece)
+		next.set(m.From, Piece{})
+	}
+
+	next.turn = p.turn.Other()
+	return next
+}
+
+// SFEN returns the position in SFEN notation without the move number,
+// i.e. "lnsgkgsnl/1r5b1/ppppppppp/9/9/9/PPPPPPPPP/1B5R1/LNSGKGSNL b -"
+func (p *Position) SFEN() string {
+	var b strings.Builder
+	for rank := 0; rank < RANKS; rank++ {
+		empty := 0
+		for file := FILES - 1; file >= 0; file-- {
+			piece := p.At(Square{File: file, Rank: rank})
+			if piece.Empty() {
+				empty++
+				continue
+			}
+			if empty > 0 {
+				b.WriteString(strconv.Itoa(empty))
+				empty = 0
+			}
+			b.WriteString(piece.String())
+		}
+		if empty > 0 {
+			b.WriteString(strconv.Itoa(empty))
+		}
+		if rank < RANKS-1 {
+			b.WriteByte('/')
+		}
+	}
+
+	if p.turn == SENTE {
+		b.WriteString(" b ")
+	} else {
+		b.WriteString(" w ")
+	}
+
+	// pieces in hand go from the rook down, sente first
+	hand := ""
+	for _, color := range [2]Color{SENTE, GOTE} {
+		for i := len(handKinds) - 1; i >= 0; i-- {
+			kind := handKinds[i]
+			count := p.hands[color][kind]
+			if count == 0 {
+				continue
+			}
+			if count > 1 {
+				hand += strconv.Itoa(count)
+			}
+			hand += Piece{Kind: kind, Color: color}.String()
+		}
+	}
+	if hand == "" {
+		hand = "-"
+	}
+	b.WriteString(hand)
+
+	return b.String()
+}
@@ -0,0 +1,159 @@
+package shogi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newEmptyPosition returns a board with only the given pieces on it
+func newEmptyPosition(turn Color, pieces map[string]Piece) *Position {
+	p := &Position{turn: turn}
+	for str, piece := range pieces {
+		sq, err := ParseSquare(str)
+		if err != nil {
+			panic(err)
+		}
+		p.set(sq, piece)
+	}
+	return p
+}
+
+func legal(p *Position, str string) bool {
+	m, err := ParseMove(str)
+	if err != nil {
+		panic(err)
+	}
+	return p.IsLegal(m)
+}
+
+func TestParseMove(t *testing.T) {
+	tests := []struct {
+		name  string
+		move  string
+		valid bool
+	}{
+		{name: "board", move: "7g7f", valid: true},
+		{name: "promotion", move: "8h2b+", valid: true},
+		{name: "drop", move: "P*5e", valid: true},
+		{name: "king drop", move: "K*5e", valid: false},
+		{name: "promoted drop", move: "P*5e+", valid: false},
+		{name: "off board", move: "0a1a", valid: false},
+		{name: "bad rank", move: "1j1i", valid: false},
+		{name: "incomplete", move: "7g", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := ParseMove(tt.move)
+			if !tt.valid {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.move, m.String())
+		})
+	}
+}
+
+func TestInitialPosition(t *testing.T) {
+	p := NewPosition()
+
+	assert.Equal(t, SENTE, p.Turn())
+	assert.Len(t, p.LegalMoves(), 30)
+	assert.True(t, legal(p, "7g7f"))
+	assert.False(t, legal(p, "7c7d"), "not gote's turn")
+}
+
+func TestCaptureToHand(t *testing.T) {
+	p := NewPosition()
+
+	for _, str := range []string{"7g7f", "3c3d", "8h2b+"} {
+		m, _ := ParseMove(str)
+		assert.NoError(t, p.Play(m), str)
+	}
+
+	assert.Equal(t, 1, p.Hand(SENTE, BISHOP))
+	sq, _ := ParseSquare("2b")
+	assert.Equal(t, Piece{Kind: BISHOP, Color: SENTE, Promoted: true}, p.At(sq))
+}
+
+func TestForcedPromotion(t *testing.T) {
+	p := newEmptyPosition(SENTE, map[string]Piece{
+		"5i": {Kind: KING, Color: SENTE},
+		"9a": {Kind: KING, Color: GOTE},
+		"1b": {Kind: PAWN, Color: SENTE},
+		"3d": {Kind: KNIGHT, Color: SENTE},
+		"5d": {Kind: SILVER, Color: SENTE},
+	})
+
+	assert.False(t, legal(p, "1b1a"))
+	assert.True(t, legal(p, "1b1a+"))
+	assert.False(t, legal(p, "3d2b"))
+	assert.True(t, legal(p, "3d2b+"))
+
+	// optional when entering the zone
+	assert.True(t, legal(p, "5d5c"))
+	assert.True(t, legal(p, "5d5c+"))
+	// not available outside of it
+	assert.False(t, legal(p, "5d4e+"))
+}
+
+func TestDrops(t *testing.T) {
+	p := newEmptyPosition(SENTE, map[string]Piece{
+		"5i": {Kind: KING, Color: SENTE},
+		"5a": {Kind: KING, Color: GOTE},
+		"3g": {Kind: PAWN, Color: SENTE},
+		"4g": {Kind: PAWN, Color: SENTE, Promoted: true},
+	})
+	p.hands[SENTE][PAWN] = 1
+	p.hands[SENTE][KNIGHT] = 1
+
+	assert.False(t, legal(p, "P*3e"), "nifu")
+	assert.True(t, legal(p, "P*4e"), "tokin does not count for nifu")
+	assert.False(t, legal(p, "P*1a"), "no moves from the last rank")
+	assert.False(t, legal(p, "N*1b"), "no moves from the second to last rank")
+	assert.True(t, legal(p, "N*1c"))
+	assert.False(t, legal(p, "N*5i"), "occupied")
+	assert.False(t, legal(p, "G*1e"), "not in hand")
+}
+
+func TestUchifuzume(t *testing.T) {
+	p := newEmptyPosition(SENTE, map[string]Piece{
+		"5i": {Kind: KING, Color: SENTE},
+		"1a": {Kind: KING, Color: GOTE},
+		"2a": {Kind: KNIGHT, Color: GOTE},
+		"2b": {Kind: SILVER, Color: GOTE},
+		"1c": {Kind: GOLD, Color: SENTE},
+	})
+	p.hands[SENTE][PAWN] = 1
+	p.hands[SENTE][GOLD] = 1
+
+	assert.False(t, legal(p, "P*1b"), "pawn drop mate")
+	assert.True(t, legal(p, "G*1b"), "other drops may mate")
+}
+
+func TestCannotIgnoreCheck(t *testing.T) {
+	p := newEmptyPosition(SENTE, map[string]Piece{
+		"5i": {Kind: KING, Color: SENTE},
+		"5a": {Kind: ROOK, Color: GOTE},
+		"1a": {Kind: KING, Color: GOTE},
+		"9i": {Kind: LANCE, Color: SENTE},
+	})
+
+	assert.True(t, p.InCheck(SENTE))
+	assert.False(t, legal(p, "9i9h"))
+	assert.True(t, legal(p, "5i4i"))
+}
+
+func TestSFEN(t *testing.T) {
+	p := NewPosition()
+	assert.Equal(t, "lnsgkgsnl/1r5b1/ppppppppp/9/9/9/PPPPPPPPP/1B5R1/LNSGKGSNL b -", p.SFEN())
+
+	for _, str := range []string{"7g7f", "3c3d", "8h2b+"} {
+		m, _ := ParseMove(str)
+		assert.NoError(t, p.Play(m), str)
+	}
+	assert.Equal(t, "lnsgkgsnl/1r5+B1/pppppp1pp/6p2/9/2P6/PP1PPPPPP/7R1/LNSGKGSNL w B", p.SFEN())
+}
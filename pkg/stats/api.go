@@ -0,0 +1,55 @@
+package stats
+
+import (
+	"context"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+)
+
+type Service interface {
+	// RecordGame adds the results of a finished game to the stats of its
+	// players in t, which has to be after every read of the transaction
+	RecordGame(context.Context, *firestore.Transaction, RecordGameRequest) error
+	GetStats(context.Context, GetStatsRequest) (*Stats, error)
+	// RebuildStats replaces the stats of every user in the tally
+	RebuildStats(context.Context, RebuildStatsRequest) error
+}
+
+type RecordGameRequest struct {
+	Results []Result `json:"results"`
+}
+
+type GetStatsRequest struct {
+	UserID format.UserID `json:"user_id"`
+}
+
+type RebuildStatsRequest struct {
+	Tally Tally `json:"tally"`
+}
+
+// Record is the games played in some kind of game and how they ended
+type Record struct {
+	Games  int `firestore:"games" json:"games"`
+	Wins   int `firestore:"wins" json:"wins"`
+	Losses int `firestore:"losses" json:"losses"`
+	Draws  int `firestore:"draws" json:"draws"`
+}
+
+type GameStats struct {
+	Game GameType `json:"game"`
+
+	Overall Record `json:"overall"`
+	// PlayerOne and PlayerTwo are the games played in each seat
+	PlayerOne Record `json:"player_one"`
+	PlayerTwo Record `json:"player_two"`
+	// HigherRated and LowerRated are the games against opponents rated
+	// above, or at most as high as the player when the game was played
+	HigherRated Record `json:"higher_rated"`
+	LowerRated  Record `json:"lower_rated"`
+}
+
+type Stats struct {
+	UserID format.UserID `json:"user_id"`
+	Games  []*GameStats  `json:"games"`
+}
@@ -0,0 +1,167 @@
+package stats
+
+import (
+	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+)
+
+type GameType string
+
+func (g GameType) String() string {
+	return string(g)
+}
+
+type GameStatus string
+
+const (
+	WIN  GameStatus = "win"
+	LOSS GameStatus = "loss"
+	DRAW GameStatus = "draw"
+)
+
+// Side is the field of the record of each seat
+type Side string
+
+const (
+	PLAYER_ONE Side = "player_one"
+	PLAYER_TWO Side = "player_two"
+)
+
+const (
+	OVERALL      = "overall"
+	HIGHER_RATED = "higher_rated"
+	LOWER_RATED  = "lower_rated"
+)
+
+// Result is a finished game from the point of view of one of its players
+type Result struct {
+	UserID format.UserID `json:"user_id"`
+	Game   GameType      `json:"game"`
+	Side   Side          `json:"side"`
+	Status GameStatus    `json:"status"`
+	// Elo and OpponentElo are the ratings before the game,
+	// which are zero for games played before they were kept
+	Elo         int `json:"elo"`
+	OpponentElo int `json:"opponent_elo"`
+}
+
+// strength is the record of the opponent strength of the result,
+// which is empty if it is not known
+func (r Result) strength() string {
+	switch {
+	case r.Elo == 0 || r.OpponentElo == 0:
+		return ""
+	case r.OpponentElo > r.Elo:
+		return HIGHER_RATED
+	default:
+		return LOWER_RATED
+	}
+}
+
+// records are the fields of every record the result counts towards
+func (r Result) records() []string {
+	records := []string{OVERALL, string(r.Side)}
+	if strength := r.strength(); strength != "" {
+		records = append(records, strength)
+	}
+	return records
+}
+
+// increments are the changes of the result to the stats document
+func (r Result) increments() map[string]interface{} {
+	fields := map[string]interface{}{
+		"user_id":   r.UserID,
+		"game_type": r.Game,
+	}
+
+	for _, record := range r.records() {
+		increment := map[string]interface{}{
+			"games": firestore.Increment(1),
+		}
+		if outcome := outcome(r.Status); outcome != "" {
+			increment[outcome] = firestore.Increment(1)
+		}
+		fields[record] = increment
+	}
+
+	return fields
+}
+
+// outcome is the field of the record counting the status
+func outcome(status GameStatus) string {
+	switch status {
+	case WIN:
+		return "wins"
+	case LOSS:
+		return "losses"
+	case DRAW:
+		return "draws"
+	default:
+		return ""
+	}
+}
+
+func (r *Record) add(status GameStatus) {
+	r.Games++
+	switch status {
+	case WIN:
+		r.Wins++
+	case LOSS:
+		r.Losses++
+	case DRAW:
+		r.Draws++
+	}
+}
+
+// StatsDocument is the stats of a user in one game type
+type StatsDocument struct {
+	UserID   format.UserID `firestore:"user_id"`
+	GameType GameType      `firestore:"game_type"`
+
+	Overall     Record `firestore:"overall"`
+	PlayerOne   Record `firestore:"player_one"`
+	PlayerTwo   Record `firestore:"player_two"`
+	HigherRated Record `firestore:"higher_rated"`
+	LowerRated  Record `firestore:"lower_rated"`
+}
+
+func (s *StatsDocument) record(field string) *Record {
+	switch field {
+	case OVERALL:
+		return &s.Overall
+	case string(PLAYER_ONE):
+		return &s.PlayerOne
+	case string(PLAYER_TWO):
+		return &s.PlayerTwo
+	case HIGHER_RATED:
+		return &s.HigherRated
+	case LOWER_RATED:
+		return &s.LowerRated
+	default:
+		return nil
+	}
+}
+
+// Tally is the stats of many users worked out in memory
+type Tally map[format.UserID]map[GameType]*StatsDocument
+
+func (t Tally) Add(result Result) {
+	games, ok := t[result.UserID]
+	if !ok {
+		games = make(map[GameType]*StatsDocument)
+		t[result.UserID] = games
+	}
+
+	doc, ok := games[result.Game]
+	if !ok {
+		doc = &StatsDocument{
+			UserID:   result.UserID,
+			GameType: result.Game,
+		}
+		games[result.Game] = doc
+	}
+
+	for _, record := range result.records() {
+		doc.record(record).add(result.Status)
+	}
+}
@@ -0,0 +1,61 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecords(t *testing.T) {
+	tests := []struct {
+		name   string
+		result Result
+		want   []string
+	}{
+		{
+			name:   "unknown ratings",
+			result: Result{Side: PLAYER_ONE, Status: WIN},
+			want:   []string{OVERALL, string(PLAYER_ONE)},
+		},
+		{
+			name:   "higher rated opponent",
+			result: Result{Side: PLAYER_TWO, Status: LOSS, Elo: 1200, OpponentElo: 1300},
+			want:   []string{OVERALL, string(PLAYER_TWO), HIGHER_RATED},
+		},
+		{
+			name:   "equally rated opponent",
+			result: Result{Side: PLAYER_ONE, Status: DRAW, Elo: 1200, OpponentElo: 1200},
+			want:   []string{OVERALL, string(PLAYER_ONE), LOWER_RATED},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.result.records())
+
+			increments := tt.result.increments()
+			for _, record := range tt.want {
+				assert.Contains(t, increments, record)
+				assert.Contains(t, increments[record], outcome(tt.result.Status))
+			}
+		})
+	}
+}
+
+func TestTally(t *testing.T) {
+	tally := Tally{}
+	tally.Add(Result{UserID: "a", Game: "janggi", Side: PLAYER_ONE, Status: WIN, Elo: 1200, OpponentElo: 1250})
+	tally.Add(Result{UserID: "a", Game: "janggi", Side: PLAYER_TWO, Status: DRAW})
+	tally.Add(Result{UserID: "a", Game: "shogi", Side: PLAYER_ONE, Status: LOSS})
+	tally.Add(Result{UserID: "b", Game: "janggi", Side: PLAYER_TWO, Status: LOSS, Elo: 1250, OpponentElo: 1200})
+
+	janggi := tally["a"]["janggi"]
+	assert.Equal(t, Record{Games: 2, Wins: 1, Draws: 1}, janggi.Overall)
+	assert.Equal(t, Record{Games: 1, Wins: 1}, janggi.PlayerOne)
+	assert.Equal(t, Record{Games: 1, Draws: 1}, janggi.PlayerTwo)
+	assert.Equal(t, Record{Games: 1, Wins: 1}, janggi.HigherRated)
+	assert.Equal(t, Record{}, janggi.LowerRated)
+
+	assert.Equal(t, Record{Games: 1, Losses: 1}, tally["a"]["shogi"].Overall)
+	assert.Equal(t, Record{Games: 1, Losses: 1}, tally["b"]["janggi"].LowerRated)
+}
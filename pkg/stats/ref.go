@@ -0,0 +1,26 @@
+package stats
+
+import (
+	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
+	"github.com/garlicgarrison/chessvars-backend/pkg/format"
+	"github.com/garlicgarrison/chessvars-backend/pkg/users"
+)
+
+const (
+	FS_STATS_COLL = "stats"
+)
+
+// user collection ->
+// user doc ->
+// stats collection ->
+// stats document (one per game type)
+
+func (s *service) getStatsRef(userID format.UserID) *firestore.CollectionRef {
+	return s.fs.Collection(users.FS_USERS_COLL).
+		Doc(userID.String()).
+		Collection(FS_STATS_COLL)
+}
+
+func (s *service) getGameStatsRef(userID format.UserID, game GameType) *firestore.DocumentRef {
+	return s.getStatsRef(userID).Doc(game.String())
+}
@@ -0,0 +1,107 @@
+package stats
+
+import (
+	"context"
+	"errors"
+
+	"github.com/garlicgarrison/chessvars-backend/pkg/firestore"
+)
+
+// MAX_BATCH_WRITES is the most writes firestore takes in one batch
+const MAX_BATCH_WRITES = 500
+
+type Config struct {
+	Firestore firestore.Firestore
+}
+
+type service struct {
+	fs firestore.Firestore
+}
+
+func NewService(cfg Config) (Service, error) {
+	if cfg.Firestore == nil {
+		return nil, errors.New("firestore required")
+	}
+
+	return &service{
+		fs: cfg.Firestore,
+	}, nil
+}
+
+func (s *service) populateStats(doc StatsDocument) *GameStats {
+	return &GameStats{
+		Game:        doc.GameType,
+		Overall:     doc.Overall,
+		PlayerOne:   doc.PlayerOne,
+		PlayerTwo:   doc.PlayerTwo,
+		HigherRated: doc.HigherRated,
+		LowerRated:  doc.LowerRated,
+	}
+}
+
+func (s *service) RecordGame(ctx context.Context, t *firestore.Transaction, request RecordGameRequest) error {
+	for _, result := range request.Results {
+		err := t.Set(
+			s.getGameStatsRef(result.UserID, result.Game),
+			result.increments(),
+			firestore.MergeAll,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *service) GetStats(ctx context.Context, request GetStatsRequest) (*Stats, error) {
+	statsSnaps, err := s.getStatsRef(request.UserID).
+		Documents(ctx).
+		GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	games := make([]*GameStats, 0)
+	for _, statsSnap := range statsSnaps {
+		var doc StatsDocument
+		err = statsSnap.DataTo(&doc)
+		if err != nil {
+			return nil, err
+		}
+
+		games = append(games, s.populateStats(doc))
+	}
+
+	return &Stats{
+		UserID: request.UserID,
+		Games:  games,
+	}, nil
+}
+
+func (s *service) RebuildStats(ctx context.Context, request RebuildStatsRequest) error {
+	batch := s.fs.Batch()
+	writes := 0
+	for userID, games := range request.Tally {
+		for game, doc := range games {
+			batch.Set(s.getGameStatsRef(userID, game), *doc)
+			writes++
+
+			if writes == MAX_BATCH_WRITES {
+				_, err := batch.Commit(ctx)
+				if err != nil {
+					return err
+				}
+				batch = s.fs.Batch()
+				writes = 0
+			}
+		}
+	}
+
+	if writes == 0 {
+		return nil
+	}
+
+	_, err := batch.Commit(ctx)
+	return err
+}